@@ -1,6 +1,7 @@
 package do
 
 import (
+	"sort"
 	"sync"
 )
 
@@ -34,6 +35,37 @@ type ServiceDescription struct {
 	Service   string
 }
 
+// ServiceDependency is an edge of the DAG: Dependent depends on Dependency.
+type ServiceDependency struct {
+	Dependent  ServiceDescription
+	Dependency ServiceDescription
+}
+
+func lessServiceDescription(a, b ServiceDescription) bool {
+	if a.ScopeName != b.ScopeName {
+		return a.ScopeName < b.ScopeName
+	}
+	if a.Service != b.Service {
+		return a.Service < b.Service
+	}
+	return a.ScopeID < b.ScopeID
+}
+
+func sortServiceDescriptions(services []ServiceDescription) {
+	sort.Slice(services, func(i, j int) bool {
+		return lessServiceDescription(services[i], services[j])
+	})
+}
+
+func sortServiceDependencies(dependencies []ServiceDependency) {
+	sort.Slice(dependencies, func(i, j int) bool {
+		if dependencies[i].Dependent != dependencies[j].Dependent {
+			return lessServiceDescription(dependencies[i].Dependent, dependencies[j].Dependent)
+		}
+		return lessServiceDescription(dependencies[i].Dependency, dependencies[j].Dependency)
+	})
+}
+
 // newDAG creates a new DAG (Directed Acyclic Graph) with initialized dependencies and dependents maps.
 // This function initializes a new dependency graph for tracking service relationships.
 //
@@ -129,20 +161,28 @@ func (d *DAG) removeService(scopeID, scopeName, serviceName string) {
 	delete(d.dependents, desc)
 }
 
-// listServicesHavingNoDependent returns the subset of the provided service names
-// (all belonging to the scope identified by scopeID/scopeName) that have no
-// dependents registered in the graph. It takes a single read lock for the
-// whole batch and does not allocate per-service dependency lists, unlike
-// repeated explainService calls.
-func (d *DAG) listServicesHavingNoDependent(scopeID, scopeName string, serviceNames []string) []string {
+// listServicesHavingNoDependent returns the subset of the pending services that have no
+// dependent in the pending set: the services that can be shut down first. Dependents that
+// are not pending are ignored, so that a shutdown can be simulated without removing services,
+// and a service depending on itself is not its own dependent. It takes a single read lock for
+// the whole batch and does not allocate per-service dependency lists, unlike repeated
+// explainService calls.
+func (d *DAG) listServicesHavingNoDependent(pending map[ServiceDescription]struct{}) []ServiceDescription {
 	d.mu.RLock()
 	defer d.mu.RUnlock()
 
-	out := make([]string, 0, len(serviceNames))
-	for _, name := range serviceNames {
-		desc := newServiceDescription(scopeID, scopeName, name)
-		if len(d.dependents[desc]) == 0 {
-			out = append(out, name)
+	out := make([]ServiceDescription, 0, len(pending))
+	for desc := range pending {
+		hasDependent := false
+		for dependent := range d.dependents[desc] {
+			if _, ok := pending[dependent]; ok && dependent != desc {
+				hasDependent = true
+				break
+			}
+		}
+
+		if !hasDependent {
+			out = append(out, desc)
 		}
 	}
 
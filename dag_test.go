@@ -86,6 +86,36 @@ func TestDAG_removeService(t *testing.T) {
 	is.Equal(expectedDependents, dag.dependents)
 }
 
+// TestDAG_listServicesHavingNoDependent checks the services that can be shut down first.
+func TestDAG_listServicesHavingNoDependent(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	edge1 := newServiceDescription("scope1", "scope1", "service1")
+	edge2 := newServiceDescription("scope2", "scope2", "service2")
+	edge3 := newServiceDescription("scope3", "scope3", "service3")
+
+	dag := newDAG()
+
+	// service1 -> service2 -> service3, and service3 depends on itself
+	dag.addDependency("scope1", "scope1", "service1", "scope2", "scope2", "service2")
+	dag.addDependency("scope2", "scope2", "service2", "scope3", "scope3", "service3")
+	dag.addDependency("scope3", "scope3", "service3", "scope3", "scope3", "service3")
+
+	pending := map[ServiceDescription]struct{}{edge1: {}, edge2: {}, edge3: {}}
+	is.ElementsMatch([]ServiceDescription{edge1}, dag.listServicesHavingNoDependent(pending))
+
+	// dependents that are not pending are ignored
+	delete(pending, edge1)
+	is.ElementsMatch([]ServiceDescription{edge2}, dag.listServicesHavingNoDependent(pending))
+
+	delete(pending, edge2)
+	is.ElementsMatch([]ServiceDescription{edge3}, dag.listServicesHavingNoDependent(pending))
+
+	is.Empty(dag.listServicesHavingNoDependent(map[ServiceDescription]struct{}{}))
+}
+
 // TestDAG_explainService checks the explanation of dependencies for a service in the DAG.
 func TestDAG_explainService(t *testing.T) {
 	t.Parallel()
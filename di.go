@@ -54,9 +54,9 @@ func NameOf[T any]() string {
 //	do.Provide(injector, func(i do.Injector) (*MyService, error) {
 //	    return &MyService{...}, nil
 //	})
-func Provide[T any](i Injector, provider Provider[T]) {
+func Provide[T any](i Injector, provider Provider[T], opts ...ServiceOption) {
 	name := inferServiceName[T]()
-	ProvideNamed(i, name, provider)
+	ProvideNamed(i, name, provider, opts...)
 }
 
 // ProvideNamed registers a named service in the DI container.
@@ -75,10 +75,10 @@ func Provide[T any](i Injector, provider Provider[T]) {
 //	do.ProvideNamed(injector, "backup-db", func(i do.Injector) (*Database, error) {
 //	    return &Database{URL: "postgres://backup.acme.dev:5432/db"}, nil
 //	})
-func ProvideNamed[T any](i Injector, name string, provider Provider[T]) {
+func ProvideNamed[T any](i Injector, name string, provider Provider[T], opts ...ServiceOption) {
 	provide(i, name, provider, func(s string, a Provider[T]) serviceWrapper[T] {
 		return newServiceLazy(s, a)
-	})
+	}, opts)
 }
 
 // ProvideValue registers a value in the DI container, using type inference to determine the service name.
@@ -89,9 +89,9 @@ func ProvideNamed[T any](i Injector, name string, provider Provider[T]) {
 // Example:
 //
 //	ProvideValue(injector, &MyService{})
-func ProvideValue[T any](i Injector, value T) {
+func ProvideValue[T any](i Injector, value T, opts ...ServiceOption) {
 	name := inferServiceName[T]()
-	ProvideNamedValue(i, name, value)
+	ProvideNamedValue(i, name, value, opts...)
 }
 
 // ProvideNamedValue registers a named value in the DI container.
@@ -104,10 +104,76 @@ func ProvideValue[T any](i Injector, value T) {
 //
 //	do.ProvideNamedValue(injector, "app-config", &Config{Port: 8080})
 //	do.ProvideNamedValue(injector, "db-config", &Config{Port: 5432})
-func ProvideNamedValue[T any](i Injector, name string, value T) {
+func ProvideNamedValue[T any](i Injector, name string, value T, opts ...ServiceOption) {
 	provide(i, name, value, func(s string, a T) serviceWrapper[T] {
 		return newServiceEager(s, a)
-	})
+	}, opts)
+}
+
+// ProvideEager registers a service in the DI container, using type inference to determine the service name.
+// Unlike Provide, the provider is executed immediately, and the resulting instance is registered as an eager service.
+// Dependencies invoked by the provider are recorded in the DAG, and the build time is tracked.
+//
+// If the provider fails, the service is not registered and the error is returned.
+//
+// Example:
+//
+//	err := do.ProvideEager(injector, func(i do.Injector) (*Database, error) {
+//	    config := do.MustInvoke[*Config](i)
+//	    return NewDatabase(config.URL)
+//	})
+func ProvideEager[T any](i Injector, provider Provider[T], opts ...ServiceOption) error {
+	name := inferServiceName[T]()
+	return ProvideNamedEager(i, name, provider, opts...)
+}
+
+// ProvideNamedEager registers a named service in the DI container.
+// Unlike ProvideNamed, the provider is executed immediately, and the resulting instance is registered as an eager service.
+// Dependencies invoked by the provider are recorded in the DAG, and the build time is tracked.
+//
+// If the provider fails, the service is not registered and the error is returned.
+//
+// Example:
+//
+//	err := do.ProvideNamedEager(injector, "main-db", func(i do.Injector) (*Database, error) {
+//	    return NewDatabase("postgres://main.acme.dev:5432/db")
+//	})
+func ProvideNamedEager[T any](i Injector, name string, provider Provider[T], opts ...ServiceOption) error {
+	_i := getInjectorOrDefault(i)
+	if _i.serviceExist(name) {
+		panic(fmt.Errorf("DI: service `%s` has already been declared", name))
+	}
+
+	service, err := newServiceEagerFromProvider(name, _i, provider)
+	if err != nil {
+		return err
+	}
+
+	provide(i, name, service, func(_ string, s *serviceEager[T]) serviceWrapper[T] {
+		return s
+	}, opts)
+
+	return nil
+}
+
+// MustProvideEager registers a service in the DI container, using type inference to determine the service name.
+// The provider is executed immediately. It panics if the provider fails.
+//
+// Example:
+//
+//	do.MustProvideEager(injector, NewDatabase)
+func MustProvideEager[T any](i Injector, provider Provider[T], opts ...ServiceOption) {
+	must0(ProvideEager(i, provider, opts...))
+}
+
+// MustProvideNamedEager registers a named service in the DI container.
+// The provider is executed immediately. It panics if the provider fails.
+//
+// Example:
+//
+//	do.MustProvideNamedEager(injector, "main-db", NewDatabase)
+func MustProvideNamedEager[T any](i Injector, name string, provider Provider[T], opts ...ServiceOption) {
+	must0(ProvideNamedEager(i, name, provider, opts...))
 }
 
 // ProvideTransient registers a factory in the DI container, using type inference to determine the service name.
@@ -128,9 +194,9 @@ func ProvideNamedValue[T any](i Injector, name string, value T) {
 //	id2, _ := do.Invoke[string](injector)
 //
 //	fmt.Println(id1 != id2) // Output: true
-func ProvideTransient[T any](i Injector, provider Provider[T]) {
+func ProvideTransient[T any](i Injector, provider Provider[T], opts ...ServiceOption) {
 	name := inferServiceName[T]()
-	ProvideNamedTransient(i, name, provider)
+	ProvideNamedTransient(i, name, provider, opts...)
 }
 
 // ProvideNamedTransient registers a named factory in the DI container.
@@ -152,10 +218,10 @@ func ProvideTransient[T any](i Injector, provider Provider[T]) {
 //	id2, _ := do.InvokeNamed[string](injector, "request-id")
 //
 //	fmt.Println(id1 != id2) // Output: true
-func ProvideNamedTransient[T any](i Injector, name string, provider Provider[T]) {
+func ProvideNamedTransient[T any](i Injector, name string, provider Provider[T], opts ...ServiceOption) {
 	provide(i, name, provider, func(s string, a Provider[T]) serviceWrapper[T] {
 		return newServiceTransient(s, a)
-	})
+	}, opts)
 }
 
 // provide is an internal helper function that handles the common logic
@@ -164,13 +230,14 @@ func ProvideNamedTransient[T any](i Injector, name string, provider Provider[T])
 // - No duplicate service names are registered
 // - The service is properly created and stored
 // - Logging is performed for successful registration.
-func provide[T any, A any](i Injector, name string, valueOrProvider A, serviceCtor func(string, A) serviceWrapper[T]) {
+func provide[T any, A any](i Injector, name string, valueOrProvider A, serviceCtor func(string, A) serviceWrapper[T], opts []ServiceOption) {
 	_i := getInjectorOrDefault(i)
 	if _i.serviceExist(name) {
 		panic(fmt.Errorf("DI: service `%s` has already been declared", name))
 	}
 
 	service := serviceCtor(name, valueOrProvider)
+	setServiceOptions(service, opts)
 	_i.serviceSet(name, service)
 
 	_i.RootScope().opts.Logf("DI: service %s injected", name)
@@ -184,9 +251,9 @@ func provide[T any, A any](i Injector, name string, valueOrProvider A, serviceCt
 // resource leaks if the original service was already instantiated.
 //
 // Play: https://go.dev/play/p/g549GqBbj-n
-func Override[T any](i Injector, provider Provider[T]) {
+func Override[T any](i Injector, provider Provider[T], opts ...ServiceOption) {
 	name := inferServiceName[T]()
-	OverrideNamed(i, name, provider)
+	OverrideNamed(i, name, provider, opts...)
 }
 
 // OverrideNamed replaces the named service in the DI container.
@@ -196,10 +263,10 @@ func Override[T any](i Injector, provider Provider[T]) {
 // already been registered. Use with caution to avoid resource leaks.
 //
 // Play: https://go.dev/play/p/-gNF1BUEB5Q
-func OverrideNamed[T any](i Injector, name string, provider Provider[T]) {
+func OverrideNamed[T any](i Injector, name string, provider Provider[T], opts ...ServiceOption) {
 	override(i, name, provider, func(s string, a Provider[T]) serviceWrapper[T] {
 		return newServiceLazy(s, a)
-	})
+	}, opts)
 }
 
 // OverrideValue replaces the value in the DI container, using type inference to determine the service name.
@@ -209,9 +276,9 @@ func OverrideNamed[T any](i Injector, name string, provider Provider[T]) {
 // The old value will not be properly cleaned up if it was already instantiated.
 //
 // Play: https://go.dev/play/p/-gNF1BUEB5Q
-func OverrideValue[T any](i Injector, value T) {
+func OverrideValue[T any](i Injector, value T, opts ...ServiceOption) {
 	name := inferServiceName[T]()
-	OverrideNamedValue(i, name, value)
+	OverrideNamedValue(i, name, value, opts...)
 }
 
 // OverrideNamedValue replaces the named value in the DI container.
@@ -221,10 +288,10 @@ func OverrideValue[T any](i Injector, value T) {
 // Use with caution to avoid resource leaks.
 //
 // Play: https://go.dev/play/p/-gNF1BUEB5Q
-func OverrideNamedValue[T any](i Injector, name string, value T) {
+func OverrideNamedValue[T any](i Injector, name string, value T, opts ...ServiceOption) {
 	override(i, name, value, func(s string, a T) serviceWrapper[T] {
 		return newServiceEager(s, a)
-	})
+	}, opts)
 }
 
 // OverrideTransient replaces the factory in the DI container, using type inference to determine the service name.
@@ -235,9 +302,9 @@ func OverrideNamedValue[T any](i Injector, name string, value T) {
 // than overriding lazy or eager services.
 //
 // Play: https://go.dev/play/p/_wYwBADbCaN
-func OverrideTransient[T any](i Injector, provider Provider[T]) {
+func OverrideTransient[T any](i Injector, provider Provider[T], opts ...ServiceOption) {
 	name := inferServiceName[T]()
-	OverrideNamedTransient(i, name, provider)
+	OverrideNamedTransient(i, name, provider, opts...)
 }
 
 // OverrideNamedTransient replaces the named factory in the DI container.
@@ -248,20 +315,21 @@ func OverrideTransient[T any](i Injector, provider Provider[T]) {
 // than overriding lazy or eager services.
 //
 // Play: https://go.dev/play/p/_wYwBADbCaN
-func OverrideNamedTransient[T any](i Injector, name string, provider Provider[T]) {
+func OverrideNamedTransient[T any](i Injector, name string, provider Provider[T], opts ...ServiceOption) {
 	override(i, name, provider, func(s string, a Provider[T]) serviceWrapper[T] {
 		return newServiceTransient(s, a)
-	})
+	}, opts)
 }
 
 // override is an internal helper function that handles the common logic
 // for overriding services in the DI container. Unlike provide, it allows
 // replacing existing services without throwing an error.
-func override[T any, A any](i Injector, name string, valueOrProvider A, serviceCtor func(string, A) serviceWrapper[T]) {
+func override[T any, A any](i Injector, name string, valueOrProvider A, serviceCtor func(string, A) serviceWrapper[T], opts []ServiceOption) {
 	_i := getInjectorOrDefault(i)
 
 	// Note: We don't check if the service exists here, allowing override
 	service := serviceCtor(name, valueOrProvider)
+	setServiceOptions(service, opts)
 	_i.serviceSet(name, service) // @TODO: should we unload/shutdown the previous service ?
 
 	_i.RootScope().opts.Logf("DI: service %s overridden", name)
@@ -511,7 +579,7 @@ func AsNamed[Initial any, Alias any](i Injector, initial string, alias string) e
 
 	provide(i, alias, nil, func(_ string, _ any) serviceWrapper[Alias] {
 		return newServiceAlias[Initial, Alias](alias, i, initial)
-	})
+	}, nil)
 
 	return nil
 }
@@ -647,9 +715,9 @@ func Package(services ...func(i Injector)) func(Injector) {
 //	    	return &Database{}, nil
 //		}),
 //	)
-func Lazy[T any](p Provider[T]) func(Injector) {
+func Lazy[T any](p Provider[T], opts ...ServiceOption) func(Injector) {
 	return func(injector Injector) {
-		Provide(injector, p)
+		Provide(injector, p, opts...)
 	}
 }
 
@@ -673,9 +741,9 @@ func Lazy[T any](p Provider[T]) func(Injector) {
 //	    	return &Database{}, nil
 //		}),
 //	)
-func LazyNamed[T any](serviceName string, p Provider[T]) func(Injector) {
+func LazyNamed[T any](serviceName string, p Provider[T], opts ...ServiceOption) func(Injector) {
 	return func(injector Injector) {
-		ProvideNamed(injector, serviceName, p)
+		ProvideNamed(injector, serviceName, p, opts...)
 	}
 }
 
@@ -696,9 +764,9 @@ func LazyNamed[T any](serviceName string, p Provider[T]) func(Injector) {
 //	var Package = do.Package(
 //		do.Eager[*Config](&Config{Port: 8080})
 //	)
-func Eager[T any](value T) func(Injector) {
+func Eager[T any](value T, opts ...ServiceOption) func(Injector) {
 	return func(injector Injector) {
-		ProvideValue(injector, value)
+		ProvideValue(injector, value, opts...)
 	}
 }
 
@@ -720,9 +788,9 @@ func Eager[T any](value T) func(Injector) {
 //	var Package = do.Package(
 //		do.EagerNamed[*Config]("app-config", &Config{Port: 8080})
 //	)
-func EagerNamed[T any](serviceName string, value T) func(Injector) {
+func EagerNamed[T any](serviceName string, value T, opts ...ServiceOption) func(Injector) {
 	return func(injector Injector) {
-		ProvideNamedValue(injector, serviceName, value)
+		ProvideNamedValue(injector, serviceName, value, opts...)
 	}
 }
 
@@ -745,9 +813,9 @@ func EagerNamed[T any](serviceName string, value T) func(Injector) {
 //	    	return &Logger{}, nil
 //		})
 //	)
-func Transient[T any](p Provider[T]) func(Injector) {
+func Transient[T any](p Provider[T], opts ...ServiceOption) func(Injector) {
 	return func(injector Injector) {
-		ProvideTransient(injector, p)
+		ProvideTransient(injector, p, opts...)
 	}
 }
 
@@ -771,9 +839,9 @@ func Transient[T any](p Provider[T]) func(Injector) {
 //	    	return &Logger{}, nil
 //		})
 //	)
-func TransientNamed[T any](serviceName string, p Provider[T]) func(Injector) {
+func TransientNamed[T any](serviceName string, p Provider[T], opts ...ServiceOption) func(Injector) {
 	return func(injector Injector) {
-		ProvideNamedTransient(injector, serviceName, p)
+		ProvideNamedTransient(injector, serviceName, p, opts...)
 	}
 }
 
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"html/template"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -31,7 +32,9 @@ Scope name: {{.ScopeName}}
 
 Service name: {{.ServiceName}}
 Service type: {{.ServiceType}}{{if .ServiceBuildTime}}
-Service build time: {{.ServiceBuildTime}}{{end}}
+Service build time: {{.ServiceBuildTime}}{{end}}{{if .TrackedInstances}}
+Tracked instances: {{.TrackedInstances}}{{end}}{{if .Ownership}}
+Ownership: {{.Ownership}}{{end}}
 Invoked: {{.Invoked}}
 
 Dependencies:
@@ -56,6 +59,18 @@ type ExplainServiceOutput struct {
 	Invoked          *stacktrace.Frame                `json:"invoked"`
 	Dependencies     []ExplainServiceDependencyOutput `json:"dependencies"`
 	Dependents       []ExplainServiceDependencyOutput `json:"dependents"`
+
+	// TransientTracking is true for transient services registered with WithTransientTracking.
+	// TrackedInstances is then the number of instances waiting for shutdown.
+	TransientTracking bool `json:"transient_tracking,omitempty"`
+	TrackedInstances  int  `json:"tracked_instances,omitempty"`
+
+	// Ownership is OwnershipBorrowed for services that are not shut down by the container: see
+	// WithOwnership. BorrowedHealthCheck and BorrowedShutdown are true when a borrowed service is
+	// health checked or shut down anyway.
+	Ownership           Ownership `json:"ownership,omitempty"`
+	BorrowedHealthCheck bool      `json:"borrowed_health_check,omitempty"`
+	BorrowedShutdown    bool      `json:"borrowed_shutdown,omitempty"`
 }
 
 // String returns a formatted string representation of the service explanation.
@@ -76,6 +91,28 @@ func (sd *ExplainServiceOutput) String() string {
 		buildTime = sd.ServiceBuildTime.String()
 	}
 
+	trackedInstances := ""
+	if sd.TransientTracking {
+		trackedInstances = strconv.Itoa(sd.TrackedInstances)
+	}
+
+	// The default ownership is not displayed.
+	ownership := ""
+	if sd.Ownership == OwnershipBorrowed {
+		excluded := []string{}
+		if !sd.BorrowedShutdown {
+			excluded = append(excluded, "not shut down")
+		}
+		if !sd.BorrowedHealthCheck {
+			excluded = append(excluded, "not health checked")
+		}
+
+		ownership = string(sd.Ownership)
+		if len(excluded) > 0 {
+			ownership += " (" + strings.Join(excluded, ", ") + ")"
+		}
+	}
+
 	return fromTemplate(
 		explainServiceTemplate,
 		map[string]string{
@@ -84,6 +121,8 @@ func (sd *ExplainServiceOutput) String() string {
 			"ServiceName":      sd.ServiceName,
 			"ServiceType":      string(sd.ServiceType),
 			"ServiceBuildTime": buildTime,
+			"TrackedInstances": trackedInstances,
+			"Ownership":        ownership,
 			"Invoked":          invoked,
 			"Dependencies": strings.Join(
 				mAp(sd.Dependencies, func(item ExplainServiceDependencyOutput, _ int) string {
@@ -230,15 +269,28 @@ func ExplainNamedService(scope Injector, name string) (description ExplainServic
 		buildTime, _ = lazy.getBuildTime()
 	}
 
+	var trackedInstances int
+	var transientTracking bool
+	if transient, ok := serviceAny.(serviceWrapperTrackedInstances); ok {
+		trackedInstances, transientTracking = transient.countTrackedInstances()
+	}
+
+	options := getServiceOptions(serviceAny)
+
 	return ExplainServiceOutput{
-		ScopeID:          serviceScope.ID(),
-		ScopeName:        serviceScope.Name(),
-		ServiceName:      name,
-		ServiceType:      service.getServiceType(),
-		ServiceBuildTime: buildTime,
-		Invoked:          invoked,
-		Dependencies:     newExplainServiceDependencies(_i, newServiceDescription(_i.ID(), _i.Name(), name), "dependencies"),
-		Dependents:       newExplainServiceDependencies(_i, newServiceDescription(_i.ID(), _i.Name(), name), "dependents"),
+		ScopeID:             serviceScope.ID(),
+		ScopeName:           serviceScope.Name(),
+		ServiceName:         name,
+		ServiceType:         service.getServiceType(),
+		ServiceBuildTime:    buildTime,
+		Invoked:             invoked,
+		Dependencies:        newExplainServiceDependencies(_i, newServiceDescription(_i.ID(), _i.Name(), name), "dependencies"),
+		Dependents:          newExplainServiceDependencies(_i, newServiceDescription(_i.ID(), _i.Name(), name), "dependents"),
+		TransientTracking:   transientTracking,
+		TrackedInstances:    trackedInstances,
+		Ownership:           options.ownership,
+		BorrowedHealthCheck: options.ownership == OwnershipBorrowed && options.checkBorrowed,
+		BorrowedShutdown:    options.ownership == OwnershipBorrowed && options.stopBorrowed,
 	}, true
 }
 
@@ -398,13 +450,17 @@ func (ids *ExplainInjectorScopeOutput) String() string {
 
 // ExplainInjectorServiceOutput contains information about a service in the scope explanation.
 // This struct provides details about a service's type, capabilities, and lifecycle state.
+// IsReadinessChecker and IsLivenessChecker are also true for healthcheckers, since
+// Healthchecker is the fallback of both checks.
 type ExplainInjectorServiceOutput struct {
-	ServiceName      string        `json:"service_name"`
-	ServiceType      ServiceType   `json:"service_type"`
-	ServiceTypeIcon  string        `json:"service_type_icon"`
-	ServiceBuildTime time.Duration `json:"service_build_time,omitempty"`
-	IsHealthchecker  bool          `json:"is_healthchecker"`
-	IsShutdowner     bool          `json:"is_shutdowner"`
+	ServiceName        string        `json:"service_name"`
+	ServiceType        ServiceType   `json:"service_type"`
+	ServiceTypeIcon    string        `json:"service_type_icon"`
+	ServiceBuildTime   time.Duration `json:"service_build_time,omitempty"`
+	IsHealthchecker    bool          `json:"is_healthchecker"`
+	IsReadinessChecker bool          `json:"is_readiness_checker"`
+	IsLivenessChecker  bool          `json:"is_liveness_checker"`
+	IsShutdowner       bool          `json:"is_shutdowner"`
 }
 
 // String returns a formatted string representation of the service.
@@ -541,6 +597,8 @@ func newExplainInjectorServices(i Injector) []ExplainInjectorServiceOutput {
 		var serviceTypeIcon string
 		var serviceBuildTime time.Duration
 		var isHealthchecker bool
+		var isReadinessChecker bool
+		var isLivenessChecker bool
 		var isShutdowner bool
 
 		if info, ok := inferServiceInfo(i, item.Service); ok {
@@ -549,16 +607,20 @@ func newExplainInjectorServices(i Injector) []ExplainInjectorServiceOutput {
 			serviceTypeIcon = serviceTypeToIcon[info.serviceType]
 			serviceBuildTime = info.serviceBuildTime
 			isHealthchecker = info.healthchecker
+			isReadinessChecker = info.readinessChecker
+			isLivenessChecker = info.livenessChecker
 			isShutdowner = info.shutdowner
 		}
 
 		return ExplainInjectorServiceOutput{
-			ServiceName:      item.Service,
-			ServiceType:      serviceType,
-			ServiceTypeIcon:  serviceTypeIcon,
-			ServiceBuildTime: serviceBuildTime,
-			IsHealthchecker:  isHealthchecker,
-			IsShutdowner:     isShutdowner,
+			ServiceName:        item.Service,
+			ServiceType:        serviceType,
+			ServiceTypeIcon:    serviceTypeIcon,
+			ServiceBuildTime:   serviceBuildTime,
+			IsHealthchecker:    isHealthchecker,
+			IsReadinessChecker: isReadinessChecker,
+			IsLivenessChecker:  isLivenessChecker,
+			IsShutdowner:       isShutdowner,
 		}
 	})
 }
@@ -2,6 +2,51 @@ package do
 
 import "context"
 
+// Initializer is an interface that services can implement to run a second initialization
+// phase, right after the provider returned. It is called by the DI container for services
+// built from a provider (lazy, eager from provider and transient services), but not for
+// values registered with ProvideValue.
+//
+// The Init method is useful for wiring back-references or priming caches. A non-nil error
+// is handled as a provider error: the service is not built and the error is returned
+// to the invoker. When Init or Validate fails, the instance is shut down if it implements
+// one of the Shutdowner interfaces, so that the resources opened by Init are released.
+//
+// Example:
+//
+//	type Cache struct {
+//	    entries map[string]string
+//	}
+//
+//	func (c *Cache) Init(ctx context.Context) error {
+//	    return c.warmup(ctx)
+//	}
+type Initializer interface {
+	Init(context.Context) error
+}
+
+// Validator is an interface that services can implement to validate their state, right
+// after the provider returned and Init has been called (see Initializer).
+//
+// A non-nil error is handled as a provider error: the service is not built and the error
+// is returned to the invoker.
+//
+// Example:
+//
+//	type Config struct {
+//	    Port int
+//	}
+//
+//	func (c *Config) Validate() error {
+//	    if c.Port <= 0 {
+//	        return fmt.Errorf("invalid port: %d", c.Port)
+//	    }
+//	    return nil
+//	}
+type Validator interface {
+	Validate() error
+}
+
 // Healthchecker is an interface that services can implement to provide health checking capabilities.
 // Services implementing this interface can be health-checked by the DI container to ensure they
 // are functioning correctly.
@@ -43,6 +88,117 @@ type HealthcheckerWithContext interface {
 	HealthCheck(context.Context) error
 }
 
+// ReadinessChecker is an interface that services can implement to report whether they can
+// serve traffic. A database that is reconnecting should fail its readiness check, but not
+// its liveness check.
+//
+// Services implementing Healthchecker or HealthcheckerWithContext, but not ReadinessChecker,
+// are checked with their HealthCheck method.
+//
+// Example:
+//
+//	func (db *Database) ReadinessCheck(ctx context.Context) error {
+//	    if db.reconnecting.Load() {
+//	        return errors.New("reconnecting")
+//	    }
+//	    return db.conn.PingContext(ctx)
+//	}
+type ReadinessChecker interface {
+	ReadinessCheck(context.Context) error
+}
+
+// LivenessChecker is an interface that services can implement to report whether they are
+// alive. A failing liveness check usually leads to a restart of the process, so it should
+// only fail on unrecoverable errors.
+//
+// Services implementing Healthchecker or HealthcheckerWithContext, but not LivenessChecker,
+// are checked with their HealthCheck method.
+//
+// Example:
+//
+//	func (w *Worker) LivenessCheck(ctx context.Context) error {
+//	    if time.Since(w.lastHeartbeat()) > time.Minute {
+//	        return errors.New("worker is stuck")
+//	    }
+//	    return nil
+//	}
+type LivenessChecker interface {
+	LivenessCheck(context.Context) error
+}
+
+// HealthCheckKind is the kind of check performed on a service.
+type HealthCheckKind string
+
+const (
+	// HealthCheckKindHealth checks services implementing Healthchecker or HealthcheckerWithContext.
+	HealthCheckKindHealth HealthCheckKind = "health"
+	// HealthCheckKindReadiness checks services implementing ReadinessChecker, with Healthchecker as a fallback.
+	HealthCheckKindReadiness HealthCheckKind = "readiness"
+	// HealthCheckKindLiveness checks services implementing LivenessChecker, with Healthchecker as a fallback.
+	HealthCheckKindLiveness HealthCheckKind = "liveness"
+)
+
+// instanceIsChecker reports whether an instance implements the check of the given kind,
+// or the Healthchecker fallback.
+func instanceIsChecker(instance any, kind HealthCheckKind) bool {
+	switch kind {
+	case HealthCheckKindReadiness:
+		if _, ok := instance.(ReadinessChecker); ok {
+			return true
+		}
+	case HealthCheckKindLiveness:
+		if _, ok := instance.(LivenessChecker); ok {
+			return true
+		}
+	case HealthCheckKindHealth:
+	}
+
+	_, ok1 := instance.(HealthcheckerWithContext)
+	_, ok2 := instance.(Healthchecker)
+	return ok1 || ok2
+}
+
+// instanceCheck runs the check of the given kind on an instance, or the Healthchecker fallback.
+// It returns nil when the instance does not implement any check.
+func instanceCheck(ctx context.Context, instance any, kind HealthCheckKind) error {
+	switch kind {
+	case HealthCheckKindReadiness:
+		if checker, ok := instance.(ReadinessChecker); ok {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			return checker.ReadinessCheck(ctx)
+		}
+	case HealthCheckKindLiveness:
+		if checker, ok := instance.(LivenessChecker); ok {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			return checker.LivenessCheck(ctx)
+		}
+	case HealthCheckKindHealth:
+	}
+
+	switch checker := instance.(type) {
+	case HealthcheckerWithContext:
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		return checker.HealthCheck(ctx)
+	case Healthchecker:
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		return checker.HealthCheck()
+	}
+
+	return nil
+}
+
 // Shutdowner is an interface that services can implement to provide graceful shutdown capabilities.
 // Services implementing this interface will be called during container shutdown to perform
 // cleanup operations.
@@ -123,6 +279,119 @@ type ShutdownerWithContextAndError interface {
 	Shutdown(context.Context) error
 }
 
+// instanceIsShutdowner reports whether an instance implements one of the Shutdowner interfaces.
+func instanceIsShutdowner(instance any) bool {
+	_, ok1 := instance.(ShutdownerWithContextAndError)
+	_, ok2 := instance.(ShutdownerWithError)
+	_, ok3 := instance.(ShutdownerWithContext)
+	_, ok4 := instance.(Shutdowner)
+	return ok1 || ok2 || ok3 || ok4
+}
+
+// instanceShutdown calls the Shutdown method of an instance. It returns nil when the instance
+// does not implement any Shutdowner interface.
+func instanceShutdown(ctx context.Context, instance any) error {
+	switch shutdowner := instance.(type) {
+	case ShutdownerWithContextAndError:
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		return shutdowner.Shutdown(ctx)
+	case ShutdownerWithError:
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		return shutdowner.Shutdown()
+	case ShutdownerWithContext:
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		shutdowner.Shutdown(ctx)
+	case Shutdowner:
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		shutdowner.Shutdown()
+	}
+
+	return nil
+}
+
+// Drainer is an interface that services can implement to stop accepting work and finish
+// in-flight work before the container shuts down. RootScope.ShutdownWithContext drains every
+// service in dependency order (dependents first), before calling any Shutdown method, so that
+// an HTTP server is drained before the database it queries is closed.
+//
+// The Drain method should respect the provided context, which expires with the drain budget
+// (see InjectorOpts.DrainPhaseTimeout).
+//
+// Example:
+//
+//	type Server struct {
+//	    srv *http.Server
+//	}
+//
+//	func (s *Server) Drain(ctx context.Context) error {
+//	    s.srv.SetKeepAlivesEnabled(false)
+//	    return s.srv.Shutdown(ctx)
+//	}
+type Drainer interface {
+	Drain(context.Context) error
+}
+
+// Reloader is an interface that services can implement to reload their configuration without
+// restarting the process, such as on SIGHUP. RootScope.Reload calls the Reload method of every
+// built service in dependency order (dependencies first), so that a configuration is reloaded
+// before the services reading it.
+//
+// Example:
+//
+//	type Config struct {
+//	    mu     sync.RWMutex
+//	    values map[string]string
+//	}
+//
+//	func (c *Config) Reload(ctx context.Context) error {
+//	    values, err := readConfigFile()
+//	    if err != nil {
+//	        return err
+//	    }
+//
+//	    c.mu.Lock()
+//	    c.values = values
+//	    c.mu.Unlock()
+//	    return nil
+//	}
+type Reloader interface {
+	Reload(context.Context) error
+}
+
+// Runner is an interface that long-running services, such as queue consumers or schedulers, can
+// implement to be supervised by the container. The Run method is started in a goroutine when the
+// service is built, and restarted according to its restart policy: see WithRestartPolicy.
+//
+// The context is canceled when the service is shut down or reset: Run should return promptly. An
+// error returned after the cancellation, other than context.Canceled, is a shutdown error.
+//
+// Example:
+//
+//	func (c *Consumer) Run(ctx context.Context) error {
+//	    for {
+//	        msg, err := c.queue.Receive(ctx)
+//	        if err != nil {
+//	            return err
+//	        }
+//	        c.handle(msg)
+//	    }
+//	}
+type Runner interface {
+	Run(context.Context) error
+}
+
 // HealthCheck returns a service status, using type inference to determine the service name.
 // This function performs a health check on a service by inferring its name from the type T.
 // The service must implement either Healthchecker or HealthcheckerWithContext interface.
@@ -219,6 +488,46 @@ func HealthCheckNamedWithContext(ctx context.Context, i Injector, name string) e
 	return getInjectorOrDefault(i).serviceHealthCheck(ctx, name)
 }
 
+// ReadinessCheckNamed returns the readiness of a named service. The service is checked with
+// its ReadinessCheck method, or its HealthCheck method as a fallback.
+//
+// Returns an error if the service is not ready, or nil if the service is ready.
+//
+// Example:
+//
+//	err := do.ReadinessCheckNamed(injector, "main-database")
+func ReadinessCheckNamed(i Injector, name string) error {
+	return ReadinessCheckNamedWithContext(context.Background(), i, name)
+}
+
+// ReadinessCheckNamedWithContext returns the readiness of a named service with context support.
+// The service is checked with its ReadinessCheck method, or its HealthCheck method as a fallback.
+//
+// Returns an error if the service is not ready, or nil if the service is ready.
+func ReadinessCheckNamedWithContext(ctx context.Context, i Injector, name string) error {
+	return getInjectorOrDefault(i).serviceCheck(ctx, name, HealthCheckKindReadiness)
+}
+
+// LivenessCheckNamed returns the liveness of a named service. The service is checked with
+// its LivenessCheck method, or its HealthCheck method as a fallback.
+//
+// Returns an error if the service is not alive, or nil if the service is alive.
+//
+// Example:
+//
+//	err := do.LivenessCheckNamed(injector, "worker")
+func LivenessCheckNamed(i Injector, name string) error {
+	return LivenessCheckNamedWithContext(context.Background(), i, name)
+}
+
+// LivenessCheckNamedWithContext returns the liveness of a named service with context support.
+// The service is checked with its LivenessCheck method, or its HealthCheck method as a fallback.
+//
+// Returns an error if the service is not alive, or nil if the service is alive.
+func LivenessCheckNamedWithContext(ctx context.Context, i Injector, name string) error {
+	return getInjectorOrDefault(i).serviceCheck(ctx, name, HealthCheckKindLiveness)
+}
+
 // Shutdown stops a service, using type inference to determine the service name.
 // This function performs a graceful shutdown on a service by inferring its name from the type T.
 // The service must implement one of the Shutdowner interfaces.
@@ -394,3 +703,101 @@ func MustShutdownNamed(i Injector, name string) {
 func MustShutdownNamedWithContext(ctx context.Context, i Injector, name string) {
 	must0(ShutdownNamedWithContext(ctx, i, name))
 }
+
+// ReleaseTransient stops tracking an instance of a transient service registered with
+// WithTransientTracking, using type inference to determine the service name. See ReleaseTransientNamed.
+//
+// Example:
+//
+//	writer := do.MustInvoke[*FileWriter](injector)
+//	defer func() {
+//	    writer.Close()
+//	    do.ReleaseTransient(injector, writer)
+//	}()
+func ReleaseTransient[T any](i Injector, instance T) bool {
+	return ReleaseTransientNamed(i, inferServiceName[T](), instance)
+}
+
+// ReleaseTransientNamed stops tracking an instance of a named transient service registered with
+// WithTransientTracking, so that it can be garbage collected before the scope shuts down. The
+// instance is not shut down: the caller is responsible for it.
+//
+// Returns false when the service is not found, or when the instance is not tracked.
+func ReleaseTransientNamed(i Injector, name string, instance any) bool {
+	serviceAny, _, ok := getInjectorOrDefault(i).serviceGetRec(name)
+	if !ok {
+		return false
+	}
+
+	service, ok := serviceAny.(serviceWrapperTrackedInstances)
+	if !ok {
+		return false
+	}
+
+	return service.untrackInstance(instance)
+}
+
+// ShutdownCascade stops a service and every service depending on it, using type inference to
+// determine the service name. See ShutdownNamedCascadeWithContext.
+//
+// Parameters:
+//   - i: The injector containing the service
+//
+// Returns a ShutdownReport containing any errors and timings that occurred during shutdown.
+//
+// Example:
+//
+//	report := do.ShutdownCascade[*Database](injector)
+//	if !report.Succeed {
+//	    log.Printf("Database shutdown failed: %v", report)
+//	}
+func ShutdownCascade[T any](i Injector) *ShutdownReport {
+	name := inferServiceName[T]()
+	return ShutdownNamedCascadeWithContext(context.Background(), i, name)
+}
+
+// ShutdownCascadeWithContext stops a service and every service depending on it, using type inference
+// to determine the service name, with context support. See ShutdownNamedCascadeWithContext.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout control
+//   - i: The injector containing the service
+//
+// Returns a ShutdownReport containing any errors and timings that occurred during shutdown.
+func ShutdownCascadeWithContext[T any](ctx context.Context, i Injector) *ShutdownReport {
+	name := inferServiceName[T]()
+	return ShutdownNamedCascadeWithContext(ctx, i, name)
+}
+
+// ShutdownNamedCascade stops a named service and every service depending on it. See ShutdownNamedCascadeWithContext.
+//
+// Parameters:
+//   - i: The injector containing the service
+//   - name: The name of the service to shutdown
+//
+// Returns a ShutdownReport containing any errors and timings that occurred during shutdown.
+func ShutdownNamedCascade(i Injector, name string) *ShutdownReport {
+	return ShutdownNamedCascadeWithContext(context.Background(), i, name)
+}
+
+// ShutdownNamedCascadeWithContext stops a named service and every service depending on it, directly
+// or not, in any scope. Unlike ShutdownNamed, no dependent keeps a reference to a closed instance.
+// Services are shut down in parallel, dependents first, and are removed from the container, so that
+// they can be provided again.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout control
+//   - i: The injector containing the service
+//   - name: The name of the service to shutdown
+//
+// Returns a ShutdownReport containing any errors and timings that occurred during shutdown.
+//
+// Example:
+//
+//	report := do.ShutdownNamedCascadeWithContext(ctx, injector, "kafka-client")
+//	log.Printf("%d services shut down", len(report.Services))
+//
+//	do.OverrideNamed(injector, "kafka-client", NewKafkaClient)
+func ShutdownNamedCascadeWithContext(ctx context.Context, i Injector, name string) *ShutdownReport {
+	return injectorScope(getInjectorOrDefault(i)).shutdownCascade(ctx, name)
+}
@@ -8,6 +8,156 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+var _ Initializer = (*lifecycleTestInitializer)(nil)
+var _ Validator = (*lifecycleTestInitializer)(nil)
+
+type lifecycleTestInitializer struct {
+	initialized   bool
+	validated     bool
+	initErr       error
+	validateErr   error
+	validatePanic bool
+}
+
+func (t *lifecycleTestInitializer) Init(ctx context.Context) error {
+	t.initialized = true
+	return t.initErr
+}
+
+func (t *lifecycleTestInitializer) Validate() error {
+	t.validated = true
+	if t.validatePanic {
+		panic("aïe")
+	}
+	return t.validateErr
+}
+
+var _ ShutdownerWithError = (*lifecycleTestShutdowner)(nil)
+
+type lifecycleTestShutdowner struct {
+	lifecycleTestInitializer
+	shutdowns   int
+	shutdownErr error
+}
+
+func (t *lifecycleTestShutdowner) Shutdown() error {
+	t.shutdowns++
+	return t.shutdownErr
+}
+
+var _ Validator = (*lifecycleTestValidator)(nil)
+
+type lifecycleTestValidator struct {
+	err error
+}
+
+func (t *lifecycleTestValidator) Validate() error {
+	return t.err
+}
+
+func TestInitializerAndValidator(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	var hookErr error
+	i := NewWithOpts(&InjectorOpts{
+		HookAfterInvocation: []func(*Scope, string, error){
+			func(scope *Scope, serviceName string, err error) {
+				hookErr = err
+			},
+		},
+	})
+
+	// lazy
+	ProvideNamed(i, "ok", func(i Injector) (*lifecycleTestInitializer, error) {
+		return &lifecycleTestInitializer{}, nil
+	})
+	svc, err := InvokeNamed[*lifecycleTestInitializer](i, "ok")
+	is.NoError(err)
+	is.NoError(hookErr)
+	is.True(svc.initialized)
+	is.True(svc.validated)
+
+	// lazy, failing validation: the service is not built and can be invoked again
+	ProvideNamed(i, "ko", func(i Injector) (*lifecycleTestInitializer, error) {
+		return &lifecycleTestInitializer{validateErr: assert.AnError}, nil
+	})
+	svc, err = InvokeNamed[*lifecycleTestInitializer](i, "ko")
+	is.ErrorIs(err, assert.AnError)
+	is.ErrorIs(hookErr, assert.AnError)
+	is.Nil(svc)
+	is.NotContains(i.ListInvokedServices(), newServiceDescription(i.ID(), i.Name(), "ko"))
+
+	// transient
+	ProvideNamedTransient(i, "transient", func(i Injector) (*lifecycleTestInitializer, error) {
+		return &lifecycleTestInitializer{initErr: assert.AnError}, nil
+	})
+	_, err = InvokeNamed[*lifecycleTestInitializer](i, "transient")
+	is.ErrorIs(err, assert.AnError)
+
+	// eager from provider
+	err = ProvideNamedEager(i, "eager", func(i Injector) (*lifecycleTestInitializer, error) {
+		return &lifecycleTestInitializer{initErr: assert.AnError}, nil
+	})
+	is.ErrorIs(err, assert.AnError)
+	is.False(i.serviceExist("eager"))
+
+	// values are not initialized
+	ProvideNamedValue(i, "value", &lifecycleTestInitializer{initErr: assert.AnError})
+	svc, err = InvokeNamed[*lifecycleTestInitializer](i, "value")
+	is.NoError(err)
+	is.False(svc.initialized)
+}
+
+var _ ReadinessChecker = (*lifecycleTestProbes)(nil)
+var _ LivenessChecker = (*lifecycleTestProbes)(nil)
+
+// lifecycleTestProbes is alive but not ready.
+type lifecycleTestProbes struct{}
+
+func (t *lifecycleTestProbes) ReadinessCheck(ctx context.Context) error {
+	return assert.AnError
+}
+
+func (t *lifecycleTestProbes) LivenessCheck(ctx context.Context) error {
+	return nil
+}
+
+func TestInstanceIsChecker(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	is.False(instanceIsChecker(42, HealthCheckKindHealth))
+	is.False(instanceIsChecker(42, HealthCheckKindReadiness))
+	is.False(instanceIsChecker(42, HealthCheckKindLiveness))
+
+	// Healthcheckers are checked by every kind of check
+	is.True(instanceIsChecker(&lazyTestHeathcheckerOK{}, HealthCheckKindHealth))
+	is.True(instanceIsChecker(&lazyTestHeathcheckerOK{}, HealthCheckKindReadiness))
+	is.True(instanceIsChecker(&lazyTestHeathcheckerOK{}, HealthCheckKindLiveness))
+
+	is.False(instanceIsChecker(&lifecycleTestProbes{}, HealthCheckKindHealth))
+	is.True(instanceIsChecker(&lifecycleTestProbes{}, HealthCheckKindReadiness))
+	is.True(instanceIsChecker(&lifecycleTestProbes{}, HealthCheckKindLiveness))
+}
+
+func TestInstanceCheck(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	ctx := context.Background()
+
+	is.NoError(instanceCheck(ctx, 42, HealthCheckKindReadiness))
+	is.Equal(assert.AnError, instanceCheck(ctx, &lazyTestHeathcheckerKO{}, HealthCheckKindHealth))
+	is.Equal(assert.AnError, instanceCheck(ctx, &lazyTestHeathcheckerKO{}, HealthCheckKindReadiness))
+	is.Equal(assert.AnError, instanceCheck(ctx, &lazyTestHeathcheckerKO{}, HealthCheckKindLiveness))
+
+	is.NoError(instanceCheck(ctx, &lifecycleTestProbes{}, HealthCheckKindHealth))
+	is.Equal(assert.AnError, instanceCheck(ctx, &lifecycleTestProbes{}, HealthCheckKindReadiness))
+	is.NoError(instanceCheck(ctx, &lifecycleTestProbes{}, HealthCheckKindLiveness))
+}
+
 func TestHealthCheck(t *testing.T) {
 	testWithTimeout(t, 100*time.Millisecond)
 	is := assert.New(t)
@@ -76,6 +226,40 @@ func TestHealthCheckNamedWithContext(t *testing.T) {
 	is.Equal(assert.AnError, HealthCheckNamedWithContext(ctx, i, "foobar"))
 }
 
+func TestReadinessCheckNamed(t *testing.T) {
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	i := New()
+
+	ProvideNamed(i, "probes", func(i Injector) (*lifecycleTestProbes, error) { return &lifecycleTestProbes{}, nil })
+	ProvideNamed(i, "healthchecker", func(i Injector) (*lazyTestHeathcheckerKO, error) { return &lazyTestHeathcheckerKO{}, nil })
+	is.NoError(ReadinessCheckNamed(i, "probes"))
+	_, _ = InvokeNamed[*lifecycleTestProbes](i, "probes")
+	_, _ = InvokeNamed[*lazyTestHeathcheckerKO](i, "healthchecker")
+
+	is.Equal(assert.AnError, ReadinessCheckNamed(i, "probes"))
+	is.Equal(assert.AnError, ReadinessCheckNamedWithContext(context.Background(), i, "probes"))
+	is.Equal(assert.AnError, ReadinessCheckNamed(i, "healthchecker"))
+	is.NoError(HealthCheckNamed(i, "probes"))
+}
+
+func TestLivenessCheckNamed(t *testing.T) {
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	i := New()
+
+	ProvideNamed(i, "probes", func(i Injector) (*lifecycleTestProbes, error) { return &lifecycleTestProbes{}, nil })
+	ProvideNamed(i, "healthchecker", func(i Injector) (*lazyTestHeathcheckerKO, error) { return &lazyTestHeathcheckerKO{}, nil })
+	_, _ = InvokeNamed[*lifecycleTestProbes](i, "probes")
+	_, _ = InvokeNamed[*lazyTestHeathcheckerKO](i, "healthchecker")
+
+	is.NoError(LivenessCheckNamed(i, "probes"))
+	is.NoError(LivenessCheckNamedWithContext(context.Background(), i, "probes"))
+	is.Equal(assert.AnError, LivenessCheckNamed(i, "healthchecker"))
+}
+
 func TestShutdown(t *testing.T) {
 	testWithTimeout(t, 100*time.Millisecond)
 	is := assert.New(t)
@@ -256,6 +440,92 @@ func TestMustShutdownNamedWithContext(t *testing.T) {
 	})
 }
 
+func TestShutdownNamedCascade(t *testing.T) {
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	recorder := &drainTestRecorder{}
+	i := New()
+	child := i.Scope("child")
+
+	provide := func(scope Injector, name string, dependencies ...string) {
+		ProvideNamed(scope, name, func(i Injector) (*drainTestService, error) {
+			for _, dependency := range dependencies {
+				_ = MustInvokeNamed[*drainTestService](i, dependency)
+			}
+			return &drainTestService{name: name, recorder: recorder}, nil
+		})
+	}
+
+	provide(i, "db")
+	provide(i, "cache", "db")
+	provide(i, "api", "cache")
+	provide(child, "worker", "db")
+	provide(i, "other")
+
+	_ = MustInvokeNamed[*drainTestService](i, "api")
+	_ = MustInvokeNamed[*drainTestService](child, "worker")
+	_ = MustInvokeNamed[*drainTestService](i, "other")
+
+	report := ShutdownNamedCascade(i, "db")
+	is.True(report.Succeed)
+	is.Empty(report.Errors)
+	is.ElementsMatch(
+		[]ServiceDescription{
+			newServiceDescription(i.ID(), i.Name(), "db"),
+			newServiceDescription(i.ID(), i.Name(), "cache"),
+			newServiceDescription(i.ID(), i.Name(), "api"),
+			newServiceDescription(child.ID(), child.Name(), "worker"),
+		},
+		report.Services,
+	)
+	is.Len(report.ServiceShutdownTime, 4)
+	is.Positive(report.ShutdownTime)
+
+	// dependents are shut down first
+	events := recorder.list()
+	is.Len(events, 4)
+	is.ElementsMatch([]string{"shutdown api", "shutdown worker"}, events[:2])
+	is.Equal([]string{"shutdown cache", "shutdown db"}, events[2:])
+
+	// the subsystem can be provided again, other services are untouched
+	is.Equal([]ServiceDescription{newServiceDescription(i.ID(), i.Name(), "other")}, i.ListProvidedServices())
+	is.False(child.serviceExist("worker"))
+	provide(i, "db")
+	_, err := InvokeNamed[*drainTestService](i, "db")
+	is.NoError(err)
+
+	// unknown service
+	report = ShutdownNamedCascade(i, "unknown")
+	is.False(report.Succeed)
+	is.ErrorIs(report.Errors[newServiceDescription(i.ID(), i.Name(), "unknown")], ErrServiceNotFound)
+}
+
+func TestShutdownCascade(t *testing.T) {
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	i := New()
+	Provide(i, func(i Injector) (*lazyTestShutdownerKOCtx, error) {
+		return &lazyTestShutdownerKOCtx{foobar: "foobar"}, nil
+	})
+	ProvideNamed(i, "dependent", func(i Injector) (int, error) {
+		_ = MustInvoke[*lazyTestShutdownerKOCtx](i)
+		return 42, nil
+	})
+	_ = MustInvokeNamed[int](i, "dependent")
+
+	report := ShutdownCascadeWithContext[*lazyTestShutdownerKOCtx](context.Background(), i)
+	is.False(report.Succeed)
+	is.Len(report.Services, 2)
+	is.Len(report.Errors, 1)
+	is.Empty(i.ListProvidedServices())
+
+	report = ShutdownCascade[*lazyTestShutdownerKOCtx](i)
+	is.False(report.Succeed)
+	is.Len(report.Services, 0)
+}
+
 func TestDoubleInjection(t *testing.T) {
 	testWithTimeout(t, 100*time.Millisecond)
 	is := assert.New(t)
@@ -293,6 +293,141 @@ func TestProvideNamedValue(t *testing.T) {
 	is.Equal(structInstance1, structInstance2, "Named struct value services should return the same value")
 }
 
+func TestProvideEager(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	type test struct {
+		foobar string
+	}
+
+	i := New()
+
+	ProvideValue(i, "foobar")
+
+	built := 0
+	err := ProvideEager(i, func(i Injector) (*test, error) {
+		built++
+		return &test{foobar: MustInvoke[string](i)}, nil
+	})
+	is.NoError(err)
+	is.Equal(1, built)
+
+	s1, ok := i.self.services["*github.com/samber/do/v2.test"]
+	is.True(ok)
+	if ok {
+		s, ok := s1.(serviceWrapper[*test])
+		is.True(ok)
+		if ok {
+			is.Equal(ServiceTypeEager, s.getServiceType())
+		}
+	}
+
+	instance1, err := Invoke[*test](i)
+	is.NoError(err)
+	is.Equal("foobar", instance1.foobar)
+
+	instance2, err := Invoke[*test](i)
+	is.NoError(err)
+	is.Same(instance1, instance2)
+	is.Equal(1, built)
+
+	// dependencies are recorded in the DAG
+	dependencies, dependents := i.dag.explainService(i.ID(), i.Name(), NameOf[*test]())
+	is.Equal([]ServiceDescription{newServiceDescription(i.ID(), i.Name(), NameOf[string]())}, dependencies)
+	is.Empty(dependents)
+
+	// build time is reported
+	explanation, ok := ExplainService[*test](i)
+	is.True(ok)
+	is.Equal(ServiceTypeEager, explanation.ServiceType)
+
+	// provider error: the service is not registered
+	err = ProvideEager(i, func(i Injector) (int, error) {
+		return 0, assert.AnError
+	})
+	is.ErrorIs(err, assert.AnError)
+	is.False(i.serviceExist(NameOf[int]()))
+
+	// missing dependency
+	err = ProvideEager(i, func(i Injector) (float64, error) {
+		_, err := Invoke[int](i)
+		return 0, err
+	})
+	is.ErrorIs(err, ErrServiceNotFound)
+
+	// duplicated service
+	is.Panics(func() {
+		_ = ProvideEager(i, func(i Injector) (*test, error) {
+			return &test{}, nil
+		})
+	})
+}
+
+func TestProvideNamedEager(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	i := New()
+
+	err := ProvideNamedEager(i, "foobar", func(i Injector) (int, error) {
+		return 42, nil
+	})
+	is.NoError(err)
+	is.Equal(42, MustInvokeNamed[int](i, "foobar"))
+
+	// circular dependency
+	err = ProvideNamedEager(i, "hello", func(i Injector) (int, error) {
+		return InvokeNamed[int](i, "hello")
+	})
+	is.ErrorIs(err, ErrCircularDependency)
+	is.False(i.serviceExist("hello"))
+}
+
+func TestMustProvideEager(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	i := New()
+
+	is.NotPanics(func() {
+		MustProvideEager(i, func(i Injector) (int, error) {
+			return 42, nil
+		})
+	})
+	is.Equal(42, MustInvoke[int](i))
+
+	is.PanicsWithError(assert.AnError.Error(), func() {
+		MustProvideEager(i, func(i Injector) (string, error) {
+			return "", assert.AnError
+		})
+	})
+}
+
+func TestMustProvideNamedEager(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	i := New()
+
+	is.NotPanics(func() {
+		MustProvideNamedEager(i, "foobar", func(i Injector) (int, error) {
+			return 42, nil
+		})
+	})
+	is.Equal(42, MustInvokeNamed[int](i, "foobar"))
+
+	is.PanicsWithError(assert.AnError.Error(), func() {
+		MustProvideNamedEager(i, "hello", func(i Injector) (string, error) {
+			return "", assert.AnError
+		})
+	})
+}
+
 func TestProvideTransient(t *testing.T) {
 	t.Parallel()
 	testWithTimeout(t, 100*time.Millisecond)
@@ -0,0 +1,133 @@
+package do
+
+import (
+	"context"
+	"time"
+)
+
+// drainWithContext drains the services of the scope tree implementing Drainer, in the shutdown
+// order: see shutdownOrder. Unlike a shutdown, services stay registered.
+func (s *Scope) drainWithContext(ctx context.Context) *ShutdownPhaseReport {
+	report := &ShutdownPhaseReport{
+		Phase:    ShutdownPhaseDrain,
+		Budget:   0,
+		Duration: 0,
+		Exceeded: false,
+		Services: []ServiceDescription{},
+		Errors:   map[ServiceDescription]error{},
+	}
+
+	order := s.shutdownOrder()
+
+	for _, wave := range order.waves {
+		mergeDrainReports(report, s.rootScope.drainServicesInParallel(ctx, order.scopes, wave.services))
+	}
+
+	return report
+}
+
+// drainServicesInParallel drains the services implementing Drainer, like
+// shutdownServicesWithoutDependenciesInParallel. Services that were not built, such as lazy
+// services never invoked, are skipped.
+func (s *RootScope) drainServicesInParallel(ctx context.Context, scopes map[string]*Scope, services []ServiceDescription) *ShutdownPhaseReport {
+	type drainTarget struct {
+		scope   *Scope
+		desc    ServiceDescription
+		service any
+		drainer Drainer
+	}
+
+	sortServiceDescriptions(services)
+
+	targets := []drainTarget{}
+	for _, desc := range services {
+		scope, ok := scopes[desc.ScopeID]
+		if !ok {
+			continue
+		}
+
+		serviceAny, ok := scope.serviceGet(desc.Service)
+		if !ok {
+			continue
+		}
+
+		if drainer, ok := serviceDrainer(serviceAny); ok {
+			targets = append(targets, drainTarget{scope: scope, desc: desc, service: serviceAny, drainer: drainer})
+		}
+	}
+
+	results := s.runShutdownJobs(len(targets), func(i int) error {
+		target := targets[i]
+
+		start := time.Now()
+		// A service overrunning the drain budget is abandoned.
+		err := raceWithContext(ctx, target.drainer.Drain, ErrShutdownTimeout)
+		s.opts.logService(logLevelError, "DI: service drained", target.scope, target.desc.Service, target.service, time.Since(start), err)
+
+		return err
+	})
+
+	report := &ShutdownPhaseReport{
+		Phase:    ShutdownPhaseDrain,
+		Budget:   0,
+		Duration: 0,
+		Exceeded: false,
+		Services: []ServiceDescription{},
+		Errors:   map[ServiceDescription]error{},
+	}
+
+	for i, target := range targets {
+		report.Services = append(report.Services, target.desc)
+		if results[i] != nil {
+			report.Errors[target.desc] = results[i]
+		}
+	}
+
+	return report
+}
+
+func serviceDrainer(service any) (Drainer, bool) {
+	if !getServiceOptions(service).isShutdownEnabled() {
+		return nil, false
+	}
+
+	svc, ok := service.(serviceWrapperBuiltInstance)
+	if !ok {
+		return nil, false
+	}
+
+	instance, ok := svc.getBuiltInstance()
+	if !ok {
+		return nil, false
+	}
+
+	drainer, ok := instance.(Drainer)
+	return drainer, ok
+}
+
+func mergeDrainReports(out *ShutdownPhaseReport, r *ShutdownPhaseReport) {
+	out.Services = append(out.Services, r.Services...)
+	for k, v := range r.Errors {
+		out.Errors[k] = v
+	}
+}
+
+// runShutdownPhase runs a phase of the shutdown of a root scope within its budget.
+func runShutdownPhase(ctx context.Context, phase ShutdownPhase, budget time.Duration, fn func(context.Context) *ShutdownPhaseReport) ShutdownPhaseReport {
+	start := time.Now()
+
+	phaseCtx := ctx
+	cancel := context.CancelFunc(func() {})
+	if budget > 0 {
+		phaseCtx, cancel = context.WithTimeout(ctx, budget)
+	}
+	defer cancel()
+
+	report := fn(phaseCtx)
+	report.Phase = phase
+	report.Budget = budget
+	report.Duration = time.Since(start)
+	report.Exceeded = budget > 0 && phaseCtx.Err() != nil && ctx.Err() == nil
+
+	return *report
+}
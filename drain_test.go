@@ -0,0 +1,157 @@
+package do
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type drainTestRecorder struct {
+	mu     sync.Mutex
+	events []string
+}
+
+func (r *drainTestRecorder) record(event string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, event)
+}
+
+func (r *drainTestRecorder) list() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string{}, r.events...)
+}
+
+var _ Drainer = (*drainTestService)(nil)
+var _ ShutdownerWithContextAndError = (*drainTestService)(nil)
+
+type drainTestService struct {
+	name     string
+	recorder *drainTestRecorder
+	drainErr error
+	release  chan struct{}
+}
+
+func (s *drainTestService) Drain(ctx context.Context) error {
+	s.recorder.record("drain " + s.name)
+	if s.release != nil {
+		<-s.release
+	}
+	return s.drainErr
+}
+
+func (s *drainTestService) Shutdown(ctx context.Context) error {
+	s.recorder.record("shutdown " + s.name)
+	return nil
+}
+
+func TestRootScope_ShutdownWithContext_drain(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	recorder := &drainTestRecorder{}
+	injector := New()
+	child := injector.Scope("child")
+
+	ProvideNamed(injector, "db", func(i Injector) (*drainTestService, error) {
+		return &drainTestService{name: "db", recorder: recorder}, nil
+	})
+	ProvideNamed(injector, "server", func(i Injector) (*drainTestService, error) {
+		_ = MustInvokeNamed[*drainTestService](i, "db")
+		return &drainTestService{name: "server", recorder: recorder}, nil
+	})
+	ProvideNamed(child, "consumer", func(i Injector) (*drainTestService, error) {
+		_ = MustInvokeNamed[*drainTestService](i, "db")
+		return &drainTestService{name: "consumer", recorder: recorder}, nil
+	})
+	ProvideNamed(injector, "unused", func(i Injector) (*drainTestService, error) {
+		return &drainTestService{name: "unused", recorder: recorder}, nil
+	})
+
+	_ = MustInvokeNamed[*drainTestService](injector, "server")
+	_ = MustInvokeNamed[*drainTestService](child, "consumer")
+
+	report := injector.Shutdown()
+	is.True(report.Succeed)
+	is.Empty(report.Error())
+
+	// dependents are drained first, and every service is drained before any shutdown
+	events := recorder.list()
+	is.Len(events, 6)
+	is.ElementsMatch([]string{"drain consumer", "drain server", "drain db"}, events[:3])
+	is.Equal("drain db", events[2])
+	is.ElementsMatch([]string{"shutdown consumer", "shutdown server", "shutdown db"}, events[3:])
+	is.Equal("shutdown db", events[5])
+
+	is.Len(report.Phases, 2)
+	is.Equal(ShutdownPhaseDrain, report.Phases[0].Phase)
+	is.Len(report.Phases[0].Services, 3)
+	is.Empty(report.Phases[0].Errors)
+	is.False(report.Phases[0].Exceeded)
+	is.Equal(ShutdownPhaseShutdown, report.Phases[1].Phase)
+	is.Len(report.Phases[1].Services, 4)
+	is.GreaterOrEqual(report.ShutdownTime, report.Phases[0].Duration+report.Phases[1].Duration)
+}
+
+func TestRootScope_ShutdownWithContext_drainBudget(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 200*time.Millisecond)
+	is := assert.New(t)
+
+	recorder := &drainTestRecorder{}
+	injector := NewWithOpts(&InjectorOpts{
+		DrainPhaseTimeout:    20 * time.Millisecond,
+		ShutdownPhaseTimeout: time.Second,
+	})
+
+	stuck := &drainTestService{name: "stuck", recorder: recorder, release: make(chan struct{})}
+	defer close(stuck.release)
+
+	ProvideNamedValue(injector, "stuck", stuck)
+	ProvideNamedValue(injector, "failing", &drainTestService{name: "failing", recorder: recorder, drainErr: assert.AnError})
+
+	start := time.Now()
+	report := injector.Shutdown()
+	is.Less(time.Since(start), 100*time.Millisecond)
+
+	// the shutdown phase runs even when the drain phase overruns its budget
+	is.False(report.Succeed)
+	is.Empty(report.Errors)
+	is.Len(report.Services, 2)
+
+	drain := report.Phases[0]
+	is.Equal(20*time.Millisecond, drain.Budget)
+	is.True(drain.Exceeded)
+	is.Len(drain.Errors, 2)
+	is.ErrorIs(drain.Errors[newServiceDescription(injector.ID(), injector.Name(), "stuck")], ErrShutdownTimeout)
+	is.ErrorIs(drain.Errors[newServiceDescription(injector.ID(), injector.Name(), "failing")], assert.AnError)
+
+	shutdown := report.Phases[1]
+	is.Equal(time.Second, shutdown.Budget)
+	is.False(shutdown.Exceeded)
+
+	is.Contains(report.Error(), "DI: shutdown errors:")
+	is.Contains(report.Error(), "[root] > failing (drain): "+assert.AnError.Error())
+}
+
+func TestScope_ShutdownWithContext_noDrain(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	recorder := &drainTestRecorder{}
+	injector := New()
+	child := injector.Scope("child")
+	ProvideNamedValue(child, "svc", &drainTestService{name: "svc", recorder: recorder})
+
+	// only the root scope runs the drain phase
+	report := child.Shutdown()
+	is.True(report.Succeed)
+	is.Nil(report.Phases)
+	is.Equal([]string{"shutdown svc"}, recorder.list())
+}
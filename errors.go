@@ -13,6 +13,9 @@ var (
 	ErrServiceNotMatch    = errors.New("DI: could not find service satisfying interface")
 	ErrCircularDependency = errors.New("DI: circular dependency detected")
 	ErrHealthCheckTimeout = errors.New("DI: health check timeout")
+	ErrShutdownTimeout    = errors.New("DI: shutdown timeout")
+	ErrShutdownForced     = errors.New("DI: shutdown forced")
+	ErrFallbackTarget     = errors.New("DI: fallback resolver target must be the invoking scope or one of its ancestors")
 )
 
 // ShutdownReport represents the result of a shutdown operation.
@@ -27,15 +30,49 @@ type ShutdownReport struct {
 	Errors              map[ServiceDescription]error
 	ShutdownTime        time.Duration
 	ServiceShutdownTime map[ServiceDescription]time.Duration
+	TimedOut            []ServiceDescription       // Services abandoned after overrunning their shutdown timeout
+	Phases              []ShutdownPhaseReport      // Set by RootScope.ShutdownWithContext only
+	TransientInstances  map[ServiceDescription]int // Tracked transient instances shut down, per service: see WithTransientTracking
+
+	// CrossScopeDependencies lists the dependencies between services of different scopes that were shut down.
+	CrossScopeDependencies []ServiceDependency
+
+	// Conflicts lists the services shut down in spite of their ordering constraints, because the
+	// constraints contradict the DAG or each other. See WithShutdownAfter.
+	Conflicts []ShutdownConflict
+
+	// Pending lists the services not shut down yet when the shutdown was forced. See
+	// RootScope.ShutdownOnSignalsWithOpts.
+	Pending []ServiceDescription
+
+	// RunnerErrors holds the last error returned by the Run method of the services implementing
+	// Runner, when it failed. Errors returned before the shutdown do not make Succeed false.
+	RunnerErrors map[ServiceDescription]error
+}
+
+// ShutdownPhase is a phase of the shutdown of a root scope.
+type ShutdownPhase string
+
+const (
+	// ShutdownPhaseDrain calls the Drain method of the services implementing Drainer.
+	ShutdownPhaseDrain ShutdownPhase = "drain"
+	// ShutdownPhaseShutdown calls the Shutdown method of the services implementing a Shutdowner interface.
+	ShutdownPhaseShutdown ShutdownPhase = "shutdown"
+)
+
+// ShutdownPhaseReport represents the result of a shutdown phase.
+type ShutdownPhaseReport struct {
+	Phase    ShutdownPhase
+	Budget   time.Duration // 0 when the phase has no budget
+	Duration time.Duration
+	Exceeded bool // true when the budget expired before the end of the phase
+	Services []ServiceDescription
+	Errors   map[ServiceDescription]error
 }
 
 // Error implements the error interface for ShutdownReport.
 // If there are errors, it returns a multiline description. Otherwise a friendly message.
 func (r ShutdownReport) Error() string {
-	if len(r.Errors) == 0 {
-		return ""
-	}
-
 	lines := []string{}
 	for k, v := range r.Errors {
 		if v != nil {
@@ -43,6 +80,22 @@ func (r ShutdownReport) Error() string {
 		}
 	}
 
+	for _, phase := range r.Phases {
+		if phase.Phase == ShutdownPhaseShutdown {
+			continue // already listed in r.Errors
+		}
+
+		for k, v := range phase.Errors {
+			if v != nil {
+				lines = append(lines, fmt.Sprintf("  - %s > %s (%s): %s", k.ScopeName, k.Service, phase.Phase, v.Error()))
+			}
+		}
+	}
+
+	if len(r.Errors) == 0 && len(lines) == 0 {
+		return ""
+	}
+
 	if len(lines) == 0 {
 		return "DI: no shutdown errors"
 	}
@@ -56,16 +109,26 @@ func (r ShutdownReport) Error() string {
 // ShutdownTime is the sum of individual report times.
 func mergeShutdownReports(reports ...*ShutdownReport) *ShutdownReport {
 	out := ShutdownReport{
-		Succeed:             true,
-		Services:            []ServiceDescription{},
-		Errors:              map[ServiceDescription]error{},
-		ShutdownTime:        0,
-		ServiceShutdownTime: map[ServiceDescription]time.Duration{},
+		Succeed:                true,
+		Services:               []ServiceDescription{},
+		Errors:                 map[ServiceDescription]error{},
+		ShutdownTime:           0,
+		ServiceShutdownTime:    map[ServiceDescription]time.Duration{},
+		TimedOut:               []ServiceDescription{},
+		TransientInstances:     map[ServiceDescription]int{},
+		CrossScopeDependencies: []ServiceDependency{},
+		Conflicts:              []ShutdownConflict{},
+		Pending:                []ServiceDescription{},
+		RunnerErrors:           map[ServiceDescription]error{},
 	}
 
 	for _, r := range reports {
 		// Merge services
 		out.Services = append(out.Services, r.Services...)
+		out.TimedOut = append(out.TimedOut, r.TimedOut...)
+		out.CrossScopeDependencies = append(out.CrossScopeDependencies, r.CrossScopeDependencies...)
+		out.Conflicts = append(out.Conflicts, r.Conflicts...)
+		out.Pending = append(out.Pending, r.Pending...)
 
 		// Merge errors
 		for k, v := range r.Errors {
@@ -79,6 +142,14 @@ func mergeShutdownReports(reports ...*ShutdownReport) *ShutdownReport {
 			out.ServiceShutdownTime[k] = v
 		}
 
+		for k, v := range r.TransientInstances {
+			out.TransientInstances[k] += v
+		}
+
+		for k, v := range r.RunnerErrors {
+			out.RunnerErrors[k] = v
+		}
+
 		out.ShutdownTime += r.ShutdownTime
 	}
 
@@ -86,3 +157,8 @@ func mergeShutdownReports(reports ...*ShutdownReport) *ShutdownReport {
 
 	return &out
 }
+
+// isShutdownTimeout returns true when a service was abandoned after overrunning its shutdown timeout.
+func isShutdownTimeout(err error) bool {
+	return errors.Is(err, ErrShutdownTimeout)
+}
@@ -0,0 +1,408 @@
+package do
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	defaultHealthMonitorInterval          = 10 * time.Second
+	defaultHealthMonitorFlappingThreshold = 4
+	healthMonitorChangesBufferSize        = 100
+)
+
+// HealthMonitorOpts configures a HealthMonitor.
+type HealthMonitorOpts struct {
+	// Interval between two checks. Defaults to 10 seconds.
+	Interval time.Duration
+
+	// Kinds of checks to run. Defaults to HealthCheckKindHealth.
+	// Use HealthCheckKindReadiness and HealthCheckKindLiveness to serve Kubernetes probes from the cache.
+	Kinds []HealthCheckKind
+
+	// A service is flapping when its status changed FlappingThreshold times within FlappingWindow.
+	// FlappingThreshold defaults to 4 and FlappingWindow defaults to 10 intervals.
+	FlappingThreshold int
+	FlappingWindow    time.Duration
+
+	// OnStatusChange is called on every status change. See HealthStatusChange.
+	OnStatusChange func(HealthStatusChange)
+}
+
+// HealthStatus is the result of the last check of a service, cached by a HealthMonitor.
+type HealthStatus struct {
+	ScopeID     string
+	ScopeName   string
+	ServiceName string
+	Kind        HealthCheckKind
+
+	Healthy   bool
+	Error     error
+	Latency   time.Duration
+	CheckedAt time.Time // Time of the last check
+	Since     time.Time // Time of the last transition, or of the first check
+	Flapping  bool
+}
+
+// HealthStatusChange is emitted when a service becomes healthy or unhealthy, or starts or stops flapping.
+// Previous is the zero value when the service was never checked before: in this case, the change
+// is only emitted when the service is unhealthy.
+type HealthStatusChange struct {
+	Previous HealthStatus
+	Current  HealthStatus
+}
+
+// HealthSnapshot is the cached status of the services checked by a HealthMonitor.
+type HealthSnapshot struct {
+	CheckedAt time.Time // Time of the last completed run, zero until the first run completed
+	Services  []HealthStatus
+}
+
+// Healthy returns true when every service of the snapshot is healthy.
+func (s HealthSnapshot) Healthy() bool {
+	for _, status := range s.Services {
+		if !status.Healthy {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Get returns the cached status of a service.
+func (s HealthSnapshot) Get(scopeID string, serviceName string, kind HealthCheckKind) (HealthStatus, bool) {
+	for _, status := range s.Services {
+		if status.ScopeID == scopeID && status.ServiceName == serviceName && status.Kind == kind {
+			return status, true
+		}
+	}
+
+	return HealthStatus{}, false
+}
+
+type healthStatusKey struct {
+	scopeID     string
+	serviceName string
+	kind        HealthCheckKind
+}
+
+type healthStatusState struct {
+	status      HealthStatus
+	transitions []time.Time
+}
+
+// HealthMonitor runs the health checks of the scope tree in the background, at a fixed interval,
+// and caches the latest result of each service. It is started with RootScope.StartHealthMonitor.
+//
+// Only the services holding an instance are checked: lazy services are checked once built.
+type HealthMonitor struct {
+	root *RootScope
+	opts HealthMonitorOpts
+
+	mu        sync.RWMutex
+	statuses  map[healthStatusKey]*healthStatusState
+	checkedAt time.Time
+
+	runMu    sync.Mutex // serializes the runs
+	changes  chan HealthStatusChange
+	ctx      context.Context
+	cancel   context.CancelFunc
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+func newHealthMonitor(root *RootScope, opts HealthMonitorOpts) *HealthMonitor {
+	if opts.Interval <= 0 {
+		opts.Interval = defaultHealthMonitorInterval
+	}
+	if len(opts.Kinds) == 0 {
+		opts.Kinds = []HealthCheckKind{HealthCheckKindHealth}
+	}
+	if opts.FlappingThreshold <= 0 {
+		opts.FlappingThreshold = defaultHealthMonitorFlappingThreshold
+	}
+	if opts.FlappingWindow <= 0 {
+		opts.FlappingWindow = 10 * opts.Interval
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &HealthMonitor{
+		root:      root,
+		opts:      opts,
+		mu:        sync.RWMutex{},
+		statuses:  map[healthStatusKey]*healthStatusState{},
+		checkedAt: time.Time{},
+		runMu:     sync.Mutex{},
+		changes:   make(chan HealthStatusChange, healthMonitorChangesBufferSize),
+		ctx:       ctx,
+		cancel:    cancel,
+		done:      make(chan struct{}),
+		stopOnce:  sync.Once{},
+	}
+}
+
+func (m *HealthMonitor) start() {
+	go func() {
+		defer close(m.done)
+
+		ticker := time.NewTicker(m.opts.Interval)
+		defer ticker.Stop()
+
+		for {
+			m.run(m.ctx)
+
+			select {
+			case <-m.ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop stops the background checks, and closes the Changes channel. The cached snapshot is kept.
+func (m *HealthMonitor) Stop() {
+	m.stopOnce.Do(func() {
+		m.cancel()
+		<-m.done
+
+		m.runMu.Lock()
+		close(m.changes)
+		m.runMu.Unlock()
+	})
+}
+
+// Monitors returns true when the monitor runs checks of the given kind.
+func (m *HealthMonitor) Monitors(kind HealthCheckKind) bool {
+	for _, k := range m.opts.Kinds {
+		if k == kind {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Changes returns a channel receiving the status changes. Changes are dropped when the channel
+// buffer is full. The channel is closed when the monitor stops.
+func (m *HealthMonitor) Changes() <-chan HealthStatusChange {
+	return m.changes
+}
+
+// Snapshot returns the cached status of the services, sorted by scope name, service name and kind.
+func (m *HealthMonitor) Snapshot() HealthSnapshot {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	services := make([]HealthStatus, 0, len(m.statuses))
+	for _, state := range m.statuses {
+		services = append(services, state.status)
+	}
+
+	sort.Slice(services, func(i, j int) bool {
+		if services[i].ScopeName != services[j].ScopeName {
+			return services[i].ScopeName < services[j].ScopeName
+		}
+		if services[i].ServiceName != services[j].ServiceName {
+			return services[i].ServiceName < services[j].ServiceName
+		}
+		return services[i].Kind < services[j].Kind
+	})
+
+	return HealthSnapshot{
+		CheckedAt: m.checkedAt,
+		Services:  services,
+	}
+}
+
+// Check runs the checks immediately, without waiting for the next interval, and returns the
+// updated snapshot.
+func (m *HealthMonitor) Check(ctx context.Context) HealthSnapshot {
+	m.run(ctx)
+	return m.Snapshot()
+}
+
+type healthMonitorTarget struct {
+	scope       *Scope
+	serviceName string
+	kind        HealthCheckKind
+}
+
+type healthMonitorResult struct {
+	target  healthMonitorTarget
+	err     error
+	latency time.Duration
+}
+
+func (m *HealthMonitor) run(ctx context.Context) {
+	m.runMu.Lock()
+	defer m.runMu.Unlock()
+
+	if m.root.opts.HealthCheckGlobalTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, m.root.opts.HealthCheckGlobalTimeout)
+		defer cancel()
+	}
+
+	targets := m.listTargets()
+	results := make([]healthMonitorResult, len(targets))
+
+	var wg sync.WaitGroup
+	for i := range targets {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			target := targets[i]
+			start := time.Now()
+			err := <-m.root.queueServiceCheck(ctx, target.scope, target.serviceName, target.kind)
+
+			results[i] = healthMonitorResult{target: target, err: err, latency: time.Since(start)}
+		}(i)
+	}
+	wg.Wait()
+
+	// Checks interrupted by Stop are not recorded.
+	if m.ctx.Err() != nil {
+		return
+	}
+
+	m.record(results)
+}
+
+// listTargets lists the services of the scope tree implementing one of the monitored checks.
+func (m *HealthMonitor) listTargets() []healthMonitorTarget {
+	targets := []healthMonitorTarget{}
+
+	var walk func(scope *Scope)
+	walk = func(scope *Scope) {
+		scope.mu.RLock()
+		for name, service := range scope.services {
+			svc, ok := service.(serviceWrapperIsChecker)
+			if !ok || !getServiceOptions(service).isCheckEnabled() {
+				continue
+			}
+
+			for _, kind := range m.opts.Kinds {
+				if svc.isChecker(kind) {
+					targets = append(targets, healthMonitorTarget{scope: scope, serviceName: name, kind: kind})
+				}
+			}
+		}
+		scope.mu.RUnlock()
+
+		for _, child := range scope.Children() {
+			walk(child)
+		}
+	}
+
+	walk(m.root.self)
+
+	return targets
+}
+
+// record updates the cached statuses, and emits the status changes. Services that are not
+// checked anymore, such as services of a scope shut down, are removed from the cache.
+func (m *HealthMonitor) record(results []healthMonitorResult) {
+	now := time.Now()
+	changes := []HealthStatusChange{}
+
+	m.mu.Lock()
+
+	statuses := make(map[healthStatusKey]*healthStatusState, len(results))
+
+	for _, result := range results {
+		key := healthStatusKey{scopeID: result.target.scope.id, serviceName: result.target.serviceName, kind: result.target.kind}
+
+		current := HealthStatus{
+			ScopeID:     result.target.scope.id,
+			ScopeName:   result.target.scope.name,
+			ServiceName: result.target.serviceName,
+			Kind:        result.target.kind,
+			Healthy:     result.err == nil,
+			Error:       result.err,
+			Latency:     result.latency,
+			CheckedAt:   now,
+			Since:       now,
+			Flapping:    false,
+		}
+
+		state, ok := m.statuses[key]
+		if !ok {
+			statuses[key] = &healthStatusState{status: current, transitions: []time.Time{}}
+			if !current.Healthy {
+				changes = append(changes, HealthStatusChange{Previous: HealthStatus{}, Current: current})
+			}
+			continue
+		}
+
+		previous := state.status
+
+		if previous.Healthy == current.Healthy {
+			current.Since = previous.Since
+		} else {
+			state.transitions = append(state.transitions, now)
+		}
+
+		state.transitions = dropTransitionsBefore(state.transitions, now.Add(-m.opts.FlappingWindow))
+		current.Flapping = len(state.transitions) >= m.opts.FlappingThreshold
+		state.status = current
+		statuses[key] = state
+
+		if previous.Healthy != current.Healthy || previous.Flapping != current.Flapping {
+			changes = append(changes, HealthStatusChange{Previous: previous, Current: current})
+		}
+	}
+
+	m.statuses = statuses
+	m.checkedAt = now
+
+	m.mu.Unlock()
+
+	for _, change := range changes {
+		m.notify(change)
+	}
+}
+
+func (m *HealthMonitor) notify(change HealthStatusChange) {
+	scope, _ := m.root.self.ChildByID(change.Current.ScopeID)
+	if change.Current.ScopeID == m.root.self.id {
+		scope = m.root.self
+	}
+
+	m.root.opts.logService(
+		logLevelWarn,
+		"DI: service health changed",
+		scope,
+		change.Current.ServiceName,
+		nil,
+		0,
+		change.Current.Error,
+		"check", string(change.Current.Kind),
+		"healthy", change.Current.Healthy,
+		"flapping", change.Current.Flapping,
+	)
+
+	if m.opts.OnStatusChange != nil {
+		m.root.opts.runHook("health status change", change.Current.ServiceName, func() { m.opts.OnStatusChange(change) })
+	}
+
+	select {
+	case m.changes <- change:
+	default:
+	}
+}
+
+func dropTransitionsBefore(transitions []time.Time, limit time.Time) []time.Time {
+	for i, t := range transitions {
+		if !t.Before(limit) {
+			return transitions[i:]
+		}
+	}
+
+	return []time.Time{}
+}
@@ -0,0 +1,208 @@
+package do
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type healthMonitorTestService struct {
+	mu  sync.Mutex
+	err error
+}
+
+func (s *healthMonitorTestService) HealthCheck() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+func (s *healthMonitorTestService) setError(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.err = err
+}
+
+func TestHealthMonitor_Check(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	root := New()
+	child := root.Scope("child")
+
+	ProvideNamedValue(root, "ok", &lazyTestHeathcheckerOK{})
+	ProvideNamedValue(child, "ko", &lazyTestHeathcheckerKO{})
+	ProvideNamedValue(root, "value", 42)
+	ProvideNamed(root, "lazy", func(i Injector) (*lazyTestHeathcheckerKO, error) { return &lazyTestHeathcheckerKO{}, nil })
+
+	monitor := newHealthMonitor(root, HealthMonitorOpts{Kinds: []HealthCheckKind{HealthCheckKindHealth, HealthCheckKindLiveness}})
+	is.True(monitor.Snapshot().CheckedAt.IsZero())
+	is.Empty(monitor.Snapshot().Services)
+
+	snapshot := monitor.Check(context.Background())
+	is.False(snapshot.CheckedAt.IsZero())
+	is.False(snapshot.Healthy())
+
+	// lazy services are not checked until built
+	is.Len(snapshot.Services, 4)
+	is.Equal("ok", snapshot.Services[0].ServiceName)
+	is.Equal(HealthCheckKindHealth, snapshot.Services[0].Kind)
+	is.Equal("ok", snapshot.Services[1].ServiceName)
+	is.Equal(HealthCheckKindLiveness, snapshot.Services[1].Kind)
+	is.Equal("ko", snapshot.Services[2].ServiceName)
+	is.Equal("child", snapshot.Services[2].ScopeName)
+
+	status, ok := snapshot.Get(child.ID(), "ko", HealthCheckKindHealth)
+	is.True(ok)
+	is.False(status.Healthy)
+	is.Equal(assert.AnError, status.Error)
+	is.Equal(snapshot.CheckedAt, status.CheckedAt)
+	is.Equal(status.CheckedAt, status.Since)
+	is.False(status.Flapping)
+
+	_, ok = snapshot.Get(root.ID(), "value", HealthCheckKindHealth)
+	is.False(ok)
+
+	_ = MustInvokeNamed[*lazyTestHeathcheckerKO](root, "lazy")
+	snapshot = monitor.Check(context.Background())
+	is.Len(snapshot.Services, 6)
+
+	// services of a scope shut down are removed
+	is.Empty(child.Shutdown().Errors)
+	snapshot = monitor.Check(context.Background())
+	is.Len(snapshot.Services, 4)
+	_, ok = snapshot.Get(child.ID(), "ko", HealthCheckKindHealth)
+	is.False(ok)
+}
+
+func TestHealthMonitor_changes(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	root := New()
+	service := &healthMonitorTestService{}
+	ProvideNamedValue(root, "svc", service)
+
+	changes := []HealthStatusChange{}
+	monitor := newHealthMonitor(root, HealthMonitorOpts{
+		FlappingThreshold: 3,
+		FlappingWindow:    time.Minute,
+		OnStatusChange: func(change HealthStatusChange) {
+			changes = append(changes, change)
+		},
+	})
+
+	// healthy at first check: no change
+	first := monitor.Check(context.Background()).Services[0]
+	is.True(first.Healthy)
+	is.Empty(changes)
+
+	// unchanged status keeps its start time
+	second := monitor.Check(context.Background()).Services[0]
+	is.Equal(first.Since, second.Since)
+	is.True(second.CheckedAt.After(first.CheckedAt) || second.CheckedAt.Equal(first.CheckedAt))
+
+	service.setError(assert.AnError)
+	monitor.Check(context.Background())
+	is.Len(changes, 1)
+	is.True(changes[0].Previous.Healthy)
+	is.False(changes[0].Current.Healthy)
+	is.Equal(assert.AnError, changes[0].Current.Error)
+	is.False(changes[0].Current.Flapping)
+
+	received := <-monitor.Changes()
+	is.Equal(changes[0], received)
+
+	service.setError(nil)
+	monitor.Check(context.Background())
+	is.Len(changes, 2)
+	is.False(changes[1].Previous.Healthy)
+	is.True(changes[1].Current.Healthy)
+
+	// third transition within the window: flapping
+	service.setError(assert.AnError)
+	snapshot := monitor.Check(context.Background())
+	is.Len(changes, 3)
+	is.True(changes[2].Current.Flapping)
+	is.True(snapshot.Services[0].Flapping)
+
+	// a panicking callback does not break the monitor
+	monitor.opts.OnStatusChange = func(HealthStatusChange) { panic("boom") }
+	service.setError(nil)
+	snapshot = monitor.Check(context.Background())
+	is.True(snapshot.Services[0].Healthy)
+}
+
+func TestHealthMonitor_unhealthyAtFirstCheck(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	root := New()
+	ProvideNamedValue(root, "ko", &lazyTestHeathcheckerKO{})
+
+	monitor := newHealthMonitor(root, HealthMonitorOpts{})
+	monitor.Check(context.Background())
+
+	change := <-monitor.Changes()
+	is.True(change.Previous.CheckedAt.IsZero())
+	is.False(change.Current.Healthy)
+	is.Equal("ko", change.Current.ServiceName)
+}
+
+func TestHealthMonitor_flappingWindow(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	now := time.Now()
+	is.Empty(dropTransitionsBefore([]time.Time{now.Add(-2 * time.Second)}, now.Add(-time.Second)))
+	is.Equal([]time.Time{now}, dropTransitionsBefore([]time.Time{now.Add(-2 * time.Second), now}, now.Add(-time.Second)))
+	is.Empty(dropTransitionsBefore([]time.Time{}, now))
+}
+
+func TestRootScope_StartHealthMonitor(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 200*time.Millisecond)
+	is := assert.New(t)
+
+	root := New()
+	service := &healthMonitorTestService{}
+	ProvideNamedValue(root, "svc", service)
+
+	_, ok := root.HealthMonitor()
+	is.False(ok)
+
+	monitor := root.StartHealthMonitor(HealthMonitorOpts{Interval: 5 * time.Millisecond})
+
+	current, ok := root.HealthMonitor()
+	is.True(ok)
+	is.Equal(monitor, current)
+	is.True(monitor.Monitors(HealthCheckKindHealth))
+	is.False(monitor.Monitors(HealthCheckKindReadiness))
+
+	// checks run in the background
+	service.setError(assert.AnError)
+	change := <-monitor.Changes()
+	is.False(change.Current.Healthy)
+	is.False(monitor.Snapshot().Healthy())
+
+	// starting another monitor stops the previous one
+	other := root.StartHealthMonitor(HealthMonitorOpts{Interval: time.Hour})
+	for range monitor.Changes() { //nolint:revive
+	}
+
+	// the monitor stops with the root scope
+	root.Shutdown()
+	_, ok = root.HealthMonitor()
+	is.False(ok)
+	for range other.Changes() { //nolint:revive
+	}
+
+	root.StopHealthMonitor() // no-op
+}
@@ -0,0 +1,370 @@
+package do
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// HealthState is the health status of a service, or of a whole report.
+type HealthState string
+
+const (
+	// HealthStateHealthy means that the check succeeded.
+	HealthStateHealthy HealthState = "healthy"
+	// HealthStateDegraded means that the check of a non-critical service failed.
+	HealthStateDegraded HealthState = "degraded"
+	// HealthStateUnhealthy means that the check of a critical service failed.
+	HealthStateUnhealthy HealthState = "unhealthy"
+	// HealthStateSkipped means that the service was not checked, because a dependency failed.
+	HealthStateSkipped HealthState = "skipped"
+)
+
+// HealthDetailer is an interface that services can implement to add details to their
+// health report, such as the size of a connection pool.
+type HealthDetailer interface {
+	HealthDetails() map[string]any
+}
+
+// HealthReportOpts configures a health report.
+type HealthReportOpts struct {
+	// Kind of check to run. Defaults to HealthCheckKindHealth.
+	Kind HealthCheckKind
+
+	// SkipAffected skips the checks of the services depending, directly or not, on a failed service.
+	// Services are then checked in dependency order: dependencies first.
+	SkipAffected bool
+}
+
+// ServiceHealth is the health of a service in a HealthReport.
+type ServiceHealth struct {
+	ServiceDescription
+
+	Status      HealthState
+	Criticality Criticality
+	Error       error
+	Latency     time.Duration
+	Details     map[string]any
+
+	// AffectedBy lists the failed services this service depends on, directly or not, according to the DAG.
+	AffectedBy []ServiceDescription
+}
+
+// HealthReport is the structured result of the health checks of a scope.
+//
+// The overall status is unhealthy when a critical service failed, degraded when only
+// non-critical services failed, and healthy otherwise.
+type HealthReport struct {
+	Status    HealthState
+	Kind      HealthCheckKind
+	Services  []ServiceHealth
+	CheckTime time.Duration
+}
+
+// Get returns the health of a service.
+func (r *HealthReport) Get(service ServiceDescription) (ServiceHealth, bool) {
+	for _, s := range r.Services {
+		if s.ServiceDescription == service {
+			return s, true
+		}
+	}
+
+	return ServiceHealth{}, false
+}
+
+// Errors returns the errors of the failed services.
+func (r *HealthReport) Errors() map[ServiceDescription]error {
+	errors := map[ServiceDescription]error{}
+	for _, s := range r.Services {
+		if s.Error != nil {
+			errors[s.ServiceDescription] = s.Error
+		}
+	}
+
+	return errors
+}
+
+type healthReportTarget struct {
+	scope        *Scope
+	name         string
+	service      any
+	desc         ServiceDescription
+	dependencies []ServiceDescription // transitive dependencies being checked too
+}
+
+// buildHealthReport checks the services of a scope and its ancestors, and propagates the
+// failures to the dependents of the failed services, using the DAG.
+func buildHealthReport(ctx context.Context, scope *Scope, opts HealthReportOpts) *HealthReport {
+	start := time.Now()
+
+	if opts.Kind == "" {
+		opts.Kind = HealthCheckKindHealth
+	}
+
+	if scope.rootScope.opts.HealthCheckGlobalTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, scope.rootScope.opts.HealthCheckGlobalTimeout)
+		defer cancel()
+	}
+
+	targets := listHealthReportTargets(scope, opts.Kind)
+	results := map[ServiceDescription]ServiceHealth{}
+
+	if opts.SkipAffected {
+		for _, wave := range healthReportWaves(targets) {
+			toCheck := []healthReportTarget{}
+
+			for _, target := range wave {
+				affectedBy := failedDependencies(target, results)
+				if len(affectedBy) == 0 {
+					toCheck = append(toCheck, target)
+					continue
+				}
+
+				results[target.desc] = ServiceHealth{
+					ServiceDescription: target.desc,
+					Status:             HealthStateSkipped,
+					Criticality:        getServiceOptions(target.service).criticality,
+					AffectedBy:         affectedBy,
+				}
+			}
+
+			for desc, health := range runHealthReportChecks(ctx, scope.rootScope, toCheck, opts.Kind) {
+				results[desc] = health
+			}
+		}
+	} else {
+		results = runHealthReportChecks(ctx, scope.rootScope, targets, opts.Kind)
+	}
+
+	report := &HealthReport{
+		Status:    HealthStateHealthy,
+		Kind:      opts.Kind,
+		Services:  make([]ServiceHealth, 0, len(targets)),
+		CheckTime: 0,
+	}
+
+	for _, target := range targets {
+		health := results[target.desc]
+		if health.Status != HealthStateSkipped {
+			health.AffectedBy = failedDependencies(target, results)
+		}
+
+		switch health.Status {
+		case HealthStateUnhealthy:
+			report.Status = HealthStateUnhealthy
+		case HealthStateDegraded:
+			if report.Status == HealthStateHealthy {
+				report.Status = HealthStateDegraded
+			}
+		case HealthStateHealthy, HealthStateSkipped:
+		}
+
+		report.Services = append(report.Services, health)
+	}
+
+	sort.Slice(report.Services, func(i, j int) bool {
+		if report.Services[i].ScopeName != report.Services[j].ScopeName {
+			return report.Services[i].ScopeName < report.Services[j].ScopeName
+		}
+		return report.Services[i].Service < report.Services[j].Service
+	})
+
+	report.CheckTime = time.Since(start)
+
+	return report
+}
+
+// listHealthReportTargets lists the checkers of a scope and its ancestors. Like HealthCheck,
+// a service of an ancestor is ignored when a service with the same name exists in a descendant.
+func listHealthReportTargets(scope *Scope, kind HealthCheckKind) []healthReportTarget {
+	targets := []healthReportTarget{}
+	seen := map[string]struct{}{}
+
+	for _, s := range append([]*Scope{scope}, scope.Ancestors()...) {
+		s.mu.RLock()
+		for name, service := range s.services {
+			if _, ok := seen[name]; ok {
+				continue
+			}
+			seen[name] = struct{}{}
+
+			if svc, ok := service.(serviceWrapperIsChecker); !ok || !svc.isChecker(kind) || !getServiceOptions(service).isCheckEnabled() {
+				continue
+			}
+
+			targets = append(targets, healthReportTarget{
+				scope:        s,
+				name:         name,
+				service:      service,
+				desc:         newServiceDescription(s.id, s.name, name),
+				dependencies: []ServiceDescription{},
+			})
+		}
+		s.mu.RUnlock()
+	}
+
+	// Walk the DAG, through the services that are not checked as well.
+	checked := map[ServiceDescription]struct{}{}
+	for _, target := range targets {
+		checked[target.desc] = struct{}{}
+	}
+
+	dag := scope.rootScope.dag
+	for i := range targets {
+		visited := map[ServiceDescription]struct{}{}
+		queue := []ServiceDescription{targets[i].desc}
+
+		for len(queue) > 0 {
+			current := queue[0]
+			queue = queue[1:]
+
+			dependencies, _ := dag.explainService(current.ScopeID, current.ScopeName, current.Service)
+			for _, dependency := range dependencies {
+				if _, ok := visited[dependency]; ok {
+					continue
+				}
+				visited[dependency] = struct{}{}
+				queue = append(queue, dependency)
+
+				if _, ok := checked[dependency]; ok {
+					targets[i].dependencies = append(targets[i].dependencies, dependency)
+				}
+			}
+		}
+	}
+
+	return targets
+}
+
+// healthReportWaves orders the targets in waves: a service is checked after its dependencies.
+// Services of a dependency cycle are checked together, in the last wave.
+func healthReportWaves(targets []healthReportTarget) [][]healthReportTarget {
+	waves := [][]healthReportTarget{}
+	done := map[ServiceDescription]struct{}{}
+	remaining := targets
+
+	for len(remaining) > 0 {
+		wave := []healthReportTarget{}
+		next := []healthReportTarget{}
+
+		for _, target := range remaining {
+			ready := true
+			for _, dependency := range target.dependencies {
+				if _, ok := done[dependency]; !ok && dependency != target.desc {
+					ready = false
+					break
+				}
+			}
+
+			if ready {
+				wave = append(wave, target)
+			} else {
+				next = append(next, target)
+			}
+		}
+
+		if len(wave) == 0 {
+			// cycle
+			wave, next = next, []healthReportTarget{}
+		}
+
+		for _, target := range wave {
+			done[target.desc] = struct{}{}
+		}
+
+		waves = append(waves, wave)
+		remaining = next
+	}
+
+	return waves
+}
+
+func failedDependencies(target healthReportTarget, results map[ServiceDescription]ServiceHealth) []ServiceDescription {
+	failed := []ServiceDescription{}
+
+	for _, dependency := range target.dependencies {
+		if dependency == target.desc {
+			continue
+		}
+
+		if health, ok := results[dependency]; ok && health.Error != nil {
+			failed = append(failed, dependency)
+		}
+	}
+
+	sort.Slice(failed, func(i, j int) bool {
+		if failed[i].ScopeName != failed[j].ScopeName {
+			return failed[i].ScopeName < failed[j].ScopeName
+		}
+		return failed[i].Service < failed[j].Service
+	})
+
+	return failed
+}
+
+// runHealthReportChecks checks the services in parallel, with the health check options.
+func runHealthReportChecks(ctx context.Context, root *RootScope, targets []healthReportTarget, kind HealthCheckKind) map[ServiceDescription]ServiceHealth {
+	results := make([]ServiceHealth, len(targets))
+
+	var wg sync.WaitGroup
+	for i := range targets {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			target := targets[i]
+			criticality := getServiceOptions(target.service).criticality
+
+			start := time.Now()
+			err := <-root.queueServiceCheck(ctx, target.scope, target.name, kind)
+			latency := time.Since(start)
+
+			status := HealthStateHealthy
+			if err != nil {
+				status = HealthStateUnhealthy
+				if criticality == CriticalityNonCritical {
+					status = HealthStateDegraded
+				}
+			}
+
+			results[i] = ServiceHealth{
+				ServiceDescription: target.desc,
+				Status:             status,
+				Criticality:        criticality,
+				Error:              err,
+				Latency:            latency,
+				Details:            serviceHealthDetails(target.service),
+				AffectedBy:         []ServiceDescription{},
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	output := make(map[ServiceDescription]ServiceHealth, len(results))
+	for _, result := range results {
+		output[result.ServiceDescription] = result
+	}
+
+	return output
+}
+
+func serviceHealthDetails(service any) map[string]any {
+	svc, ok := service.(serviceWrapperBuiltInstance)
+	if !ok {
+		return nil
+	}
+
+	instance, ok := svc.getBuiltInstance()
+	if !ok {
+		return nil
+	}
+
+	if detailer, ok := instance.(HealthDetailer); ok {
+		return detailer.HealthDetails()
+	}
+
+	return nil
+}
@@ -0,0 +1,181 @@
+package do
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type healthReportTestService struct {
+	err     error
+	details map[string]any
+}
+
+func (s *healthReportTestService) HealthCheck() error { return s.err }
+
+func (s *healthReportTestService) HealthDetails() map[string]any { return s.details }
+
+type healthReportTestRepository struct{}
+
+type healthReportTestAPI struct {
+	healthReportTestService
+}
+
+// newHealthReportTestInjector registers: api -> repository -> db, and a cache.
+// The repository is not a healthchecker.
+func newHealthReportTestInjector(dbErr error, cacheErr error) *RootScope {
+	i := New()
+
+	ProvideNamed(i, "db", func(i Injector) (*healthReportTestService, error) {
+		return &healthReportTestService{err: dbErr, details: map[string]any{"pool": 10}}, nil
+	})
+	ProvideNamed(i, "repository", func(i Injector) (*healthReportTestRepository, error) {
+		_ = MustInvokeNamed[*healthReportTestService](i, "db")
+		return &healthReportTestRepository{}, nil
+	})
+	ProvideNamed(i, "api", func(i Injector) (*healthReportTestAPI, error) {
+		_ = MustInvokeNamed[*healthReportTestRepository](i, "repository")
+		return &healthReportTestAPI{}, nil
+	})
+	ProvideNamed(i, "cache", func(i Injector) (*healthReportTestService, error) {
+		return &healthReportTestService{err: cacheErr}, nil
+	}, WithCriticality(CriticalityNonCritical))
+
+	_ = MustInvokeNamed[*healthReportTestAPI](i, "api")
+	_ = MustInvokeNamed[*healthReportTestService](i, "cache")
+
+	return i
+}
+
+func TestHealthReport(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	i := newHealthReportTestInjector(nil, nil)
+	db := newServiceDescription(i.ID(), i.Name(), "db")
+
+	report := i.HealthReport(HealthReportOpts{})
+	is.Equal(HealthStateHealthy, report.Status)
+	is.Equal(HealthCheckKindHealth, report.Kind)
+	is.Len(report.Services, 3) // the repository is not a healthchecker
+	is.Equal("api", report.Services[0].Service)
+	is.Equal("cache", report.Services[1].Service)
+	is.Equal("db", report.Services[2].Service)
+	is.Empty(report.Errors())
+
+	health, ok := report.Get(db)
+	is.True(ok)
+	is.Equal(HealthStateHealthy, health.Status)
+	is.Equal(CriticalityCritical, health.Criticality)
+	is.Equal(map[string]any{"pool": 10}, health.Details)
+	is.Empty(health.AffectedBy)
+
+	_, ok = report.Get(newServiceDescription(i.ID(), i.Name(), "repository"))
+	is.False(ok)
+}
+
+func TestHealthReport_criticality(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	i := newHealthReportTestInjector(nil, assert.AnError)
+	cache := newServiceDescription(i.ID(), i.Name(), "cache")
+
+	report := i.HealthReport(HealthReportOpts{})
+	is.Equal(HealthStateDegraded, report.Status)
+	is.Equal(map[ServiceDescription]error{cache: assert.AnError}, report.Errors())
+
+	health, _ := report.Get(cache)
+	is.Equal(HealthStateDegraded, health.Status)
+	is.Equal(CriticalityNonCritical, health.Criticality)
+}
+
+func TestHealthReport_propagation(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	i := newHealthReportTestInjector(assert.AnError, nil)
+	db := newServiceDescription(i.ID(), i.Name(), "db")
+	api := newServiceDescription(i.ID(), i.Name(), "api")
+
+	// the api is checked, and marked as affected through the repository
+	report := i.HealthReportWithContext(context.Background(), HealthReportOpts{})
+	is.Equal(HealthStateUnhealthy, report.Status)
+
+	health, _ := report.Get(db)
+	is.Equal(HealthStateUnhealthy, health.Status)
+	is.Equal(assert.AnError, health.Error)
+
+	health, _ = report.Get(api)
+	is.Equal(HealthStateHealthy, health.Status)
+	is.Equal([]ServiceDescription{db}, health.AffectedBy)
+
+	// the api is skipped
+	report = i.HealthReport(HealthReportOpts{SkipAffected: true})
+	is.Equal(HealthStateUnhealthy, report.Status)
+
+	health, _ = report.Get(api)
+	is.Equal(HealthStateSkipped, health.Status)
+	is.NoError(health.Error)
+	is.Equal([]ServiceDescription{db}, health.AffectedBy)
+
+	health, _ = report.Get(newServiceDescription(i.ID(), i.Name(), "cache"))
+	is.Equal(HealthStateHealthy, health.Status)
+	is.Empty(health.AffectedBy)
+}
+
+func TestHealthReport_scopes(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	i := New()
+	child := i.Scope("child")
+
+	ProvideNamedValue(i, "a", &healthReportTestService{err: assert.AnError})
+	ProvideNamedValue(child, "b", &healthReportTestService{})
+	ProvideNamedValue(child, "a", &healthReportTestService{}) // overrides the ancestor service
+
+	report := child.HealthReport(HealthReportOpts{Kind: HealthCheckKindReadiness})
+	is.Equal(HealthStateHealthy, report.Status)
+	is.Equal(HealthCheckKindReadiness, report.Kind)
+	is.Len(report.Services, 2)
+	is.Equal("child", report.Services[0].ScopeName)
+
+	report = i.HealthReport(HealthReportOpts{})
+	is.Equal(HealthStateUnhealthy, report.Status)
+	is.Len(report.Services, 1)
+}
+
+func TestHealthReportWaves(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	a := ServiceDescription{ScopeID: "1", ScopeName: "root", Service: "a"}
+	b := ServiceDescription{ScopeID: "1", ScopeName: "root", Service: "b"}
+	c := ServiceDescription{ScopeID: "1", ScopeName: "root", Service: "c"}
+
+	waves := healthReportWaves([]healthReportTarget{
+		{desc: c, dependencies: []ServiceDescription{a, b}},
+		{desc: b, dependencies: []ServiceDescription{a}},
+		{desc: a, dependencies: []ServiceDescription{}},
+	})
+	is.Len(waves, 3)
+	is.Equal(a, waves[0][0].desc)
+	is.Equal(b, waves[1][0].desc)
+	is.Equal(c, waves[2][0].desc)
+
+	// cycles are checked together
+	waves = healthReportWaves([]healthReportTarget{
+		{desc: a, dependencies: []ServiceDescription{b}},
+		{desc: b, dependencies: []ServiceDescription{a}},
+	})
+	is.Len(waves, 1)
+	is.Len(waves[0], 2)
+}
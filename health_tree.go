@@ -0,0 +1,143 @@
+package do
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// HealthCheckTreeOutput is the result of the health checks of a scope tree. It mirrors
+// ExplainInjectorOutput: the ancestors of the scope, the scope and its descendants.
+type HealthCheckTreeOutput struct {
+	ScopeID   string
+	ScopeName string
+	DAG       []HealthCheckScopeOutput
+}
+
+// HealthCheckScopeOutput is the result of the health checks of a scope, and of its children.
+type HealthCheckScopeOutput struct {
+	ScopeID   string
+	ScopeName string
+	Services  map[string]error // A nil error indicates a successful health check
+	Children  []HealthCheckScopeOutput
+
+	IsAncestor bool
+	IsChildren bool
+}
+
+// Results returns the result of every service of the tree, keyed by ServiceDescription,
+// since service names may collide across scopes.
+func (o HealthCheckTreeOutput) Results() map[ServiceDescription]error {
+	results := map[ServiceDescription]error{}
+
+	var walk func(scopes []HealthCheckScopeOutput)
+	walk = func(scopes []HealthCheckScopeOutput) {
+		for _, scope := range scopes {
+			for name, err := range scope.Services {
+				results[newServiceDescription(scope.ScopeID, scope.ScopeName, name)] = err
+			}
+			walk(scope.Children)
+		}
+	}
+	walk(o.DAG)
+
+	return results
+}
+
+// Healthy returns true when every service of the tree is healthy.
+func (o HealthCheckTreeOutput) Healthy() bool {
+	for _, err := range o.Results() {
+		if err != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// String returns a human-readable representation of the tree.
+func (o HealthCheckTreeOutput) String() string {
+	lines := []string{}
+
+	var walk func(scopes []HealthCheckScopeOutput, indent string)
+	walk = func(scopes []HealthCheckScopeOutput, indent string) {
+		for _, scope := range scopes {
+			lines = append(lines, fmt.Sprintf("%s%s (ID: %s)", indent, scope.ScopeName, scope.ScopeID))
+
+			names := make([]string, 0, len(scope.Services))
+			for name := range scope.Services {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			for _, name := range names {
+				if err := scope.Services[name]; err != nil {
+					lines = append(lines, fmt.Sprintf("%s  ❌ %s: %s", indent, name, err.Error()))
+				} else {
+					lines = append(lines, fmt.Sprintf("%s  ✅ %s", indent, name))
+				}
+			}
+
+			walk(scope.Children, indent+"  ")
+		}
+	}
+	walk(o.DAG, "")
+
+	return strings.Join(lines, "\n")
+}
+
+// checkTree checks every service of the scope tree, as described by ExplainInjector.
+func (s *Scope) checkTree(ctx context.Context, kind HealthCheckKind) HealthCheckTreeOutput {
+	if s.rootScope.opts.HealthCheckGlobalTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.rootScope.opts.HealthCheckGlobalTimeout)
+		defer cancel()
+	}
+
+	explain := ExplainInjector(s)
+
+	// Queue every check before waiting for the results, so that checks run in parallel.
+	asyncResults := map[ServiceDescription]<-chan error{}
+
+	var queue func(scopes []ExplainInjectorScopeOutput)
+	queue = func(scopes []ExplainInjectorScopeOutput) {
+		for _, scope := range scopes {
+			if target := injectorScope(scope.Scope); target != nil {
+				for _, service := range scope.Services {
+					desc := newServiceDescription(scope.ScopeID, scope.ScopeName, service.ServiceName)
+					asyncResults[desc] = s.rootScope.queueServiceCheck(ctx, target, service.ServiceName, kind)
+				}
+			}
+			queue(scope.Children)
+		}
+	}
+	queue(explain.DAG)
+
+	var collect func(scopes []ExplainInjectorScopeOutput) []HealthCheckScopeOutput
+	collect = func(scopes []ExplainInjectorScopeOutput) []HealthCheckScopeOutput {
+		return mAp(scopes, func(scope ExplainInjectorScopeOutput, _ int) HealthCheckScopeOutput {
+			services := map[string]error{}
+			for _, service := range scope.Services {
+				if err, ok := asyncResults[newServiceDescription(scope.ScopeID, scope.ScopeName, service.ServiceName)]; ok {
+					services[service.ServiceName] = <-err
+				}
+			}
+
+			return HealthCheckScopeOutput{
+				ScopeID:    scope.ScopeID,
+				ScopeName:  scope.ScopeName,
+				Services:   services,
+				Children:   collect(scope.Children),
+				IsAncestor: scope.IsAncestor,
+				IsChildren: scope.IsChildren,
+			}
+		})
+	}
+
+	return HealthCheckTreeOutput{
+		ScopeID:   explain.ScopeID,
+		ScopeName: explain.ScopeName,
+		DAG:       collect(explain.DAG),
+	}
+}
@@ -0,0 +1,78 @@
+package do
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScope_HealthCheckTree(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	root := New()
+	tenantA := root.Scope("tenant-a")
+	tenantB := root.Scope("tenant-b")
+	worker := tenantA.Scope("worker")
+
+	ProvideNamedValue(root, "db", &lazyTestHeathcheckerOK{})
+	ProvideNamedValue(tenantA, "client", &lazyTestHeathcheckerOK{})
+	ProvideNamedValue(tenantB, "client", &lazyTestHeathcheckerKO{})
+	ProvideNamedValue(worker, "queue", &lazyTestHeathcheckerOK{})
+	ProvideNamedValue(worker, "config", 42)
+
+	// HealthCheck of the root scope does not see the child scopes
+	is.Equal(map[string]error{"db": nil}, root.HealthCheck())
+
+	tree := root.HealthCheckTree()
+	is.Equal(root.ID(), tree.ScopeID)
+	is.False(tree.Healthy())
+	is.Equal(
+		map[ServiceDescription]error{
+			newServiceDescription(root.ID(), root.Name(), "db"):           nil,
+			newServiceDescription(tenantA.ID(), tenantA.Name(), "client"): nil,
+			newServiceDescription(tenantB.ID(), tenantB.Name(), "client"): assert.AnError,
+			newServiceDescription(worker.ID(), worker.Name(), "queue"):    nil,
+			newServiceDescription(worker.ID(), worker.Name(), "config"):   nil,
+		},
+		tree.Results(),
+	)
+
+	// the tree mirrors ExplainInjector
+	is.Len(tree.DAG, 1)
+	is.Equal(root.ID(), tree.DAG[0].ScopeID)
+	is.Equal(map[string]error{"db": nil}, tree.DAG[0].Services)
+	is.Len(tree.DAG[0].Children, 2)
+	for _, child := range tree.DAG[0].Children {
+		is.True(child.IsChildren)
+	}
+
+	is.Contains(tree.String(), "❌ client: "+assert.AnError.Error())
+	is.Contains(tree.String(), "✅ queue")
+
+	// from a child scope: ancestors and descendants
+	tree = tenantA.HealthCheckTreeWithContext(context.Background())
+	is.True(tree.Healthy())
+	is.Len(tree.Results(), 4)
+	is.Len(tree.DAG, 1)
+	is.Equal(root.ID(), tree.DAG[0].ScopeID)
+	is.Len(tree.DAG[0].Children, 1)
+	is.Equal(tenantA.ID(), tree.DAG[0].Children[0].ScopeID)
+	is.Equal(worker.ID(), tree.DAG[0].Children[0].Children[0].ScopeID)
+}
+
+func TestScope_HealthCheckTree_timeout(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	root := NewWithOpts(&InjectorOpts{HealthCheckTimeout: 5 * time.Millisecond})
+	child := root.Scope("child")
+	ProvideNamedValue(child, "slow", &lazyTestHeathcheckerOKTimeout{})
+
+	results := root.HealthCheckTree().Results()
+	is.ErrorIs(results[newServiceDescription(child.ID(), child.Name(), "slow")], ErrHealthCheckTimeout)
+}
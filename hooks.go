@@ -0,0 +1,238 @@
+package do
+
+import (
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/samber/do/v2/stacktrace"
+)
+
+// RegistrationEvent is sent to the registration event hooks, after a service is registered in a scope.
+// See InjectorOpts.HookRegistrationEvent and RootScope.AddRegistrationEventHook.
+type RegistrationEvent struct {
+	Scope         *Scope           // The scope where the service is registered
+	ServiceName   string           // The name of the service
+	ServiceType   ServiceType      // The type of service (lazy, eager, transient, alias)
+	ReflectType   reflect.Type     // The type of the service instance
+	ProviderFrame stacktrace.Frame // The location of the provider, if any
+}
+
+// InvocationEvent is sent to the invocation event hooks, after a service is invoked.
+// See InjectorOpts.HookInvocationEvent and RootScope.AddInvocationEventHook.
+type InvocationEvent struct {
+	Scope            *Scope             // The scope where the service is registered
+	ServiceName      string             // The name of the service
+	ServiceType      ServiceType        // The type of service (lazy, eager, transient, alias)
+	ReflectType      reflect.Type       // The type of the service instance
+	InvokerChain     []string           // The chain of invoked services, from the first invoker to this service
+	Built            bool               // True when the invocation built a new instance, false on cache hit
+	Duration         time.Duration      // The duration of the invocation, including middlewares
+	Error            error              // The invocation error, if any
+	ProviderFrame    stacktrace.Frame   // The location of the provider, if any
+	InvocationFrames []stacktrace.Frame // The locations where the service has been invoked
+}
+
+// HealthCheckEvent is sent to the health check event hooks, after a service is health checked.
+// See InjectorOpts.HookHealthCheckEvent and RootScope.AddHealthCheckEventHook.
+type HealthCheckEvent struct {
+	Scope       *Scope          // The scope where the service is registered
+	ServiceName string          // The name of the service
+	ServiceType ServiceType     // The type of service (lazy, eager, transient, alias)
+	ReflectType reflect.Type    // The type of the service instance
+	Kind        HealthCheckKind // The kind of check (health, readiness or liveness)
+	Duration    time.Duration   // The duration of the health check
+	Error       error           // The health check error, if any
+}
+
+// ShutdownEvent is sent to the shutdown event hooks, after a service is shut down.
+// See InjectorOpts.HookShutdownEvent and RootScope.AddShutdownEventHook.
+type ShutdownEvent struct {
+	Scope       *Scope        // The scope where the service was registered
+	ServiceName string        // The name of the service
+	ServiceType ServiceType   // The type of service (lazy, eager, transient, alias)
+	ReflectType reflect.Type  // The type of the service instance
+	Duration    time.Duration // The duration of the shutdown
+	Error       error         // The shutdown error, if any
+}
+
+// newHookRegistry creates a registry of event hooks, initialized with the provided hooks.
+func newHookRegistry[E any](hooks []func(E)) *hookRegistry[E] {
+	registry := &hookRegistry[E]{
+		mu:    sync.RWMutex{},
+		seq:   0,
+		hooks: []registeredHook[E]{},
+	}
+
+	for _, hook := range hooks {
+		registry.add(hook)
+	}
+
+	return registry
+}
+
+// hookRegistry is a thread-safe list of event hooks. Each hook is identified by
+// a sequence number, since functions are not comparable in Go, so that it can be
+// unregistered.
+type hookRegistry[E any] struct {
+	mu    sync.RWMutex
+	seq   uint64
+	hooks []registeredHook[E]
+}
+
+type registeredHook[E any] struct {
+	id   uint64
+	hook func(E)
+}
+
+// add registers a hook and returns a function unregistering it.
+func (r *hookRegistry[E]) add(hook func(E)) func() {
+	r.mu.Lock()
+	r.seq++
+	id := r.seq
+	r.hooks = append(r.hooks, registeredHook[E]{id: id, hook: hook})
+	r.mu.Unlock()
+
+	return func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		r.hooks = filter(r.hooks, func(item registeredHook[E], _ int) bool {
+			return item.id != id
+		})
+	}
+}
+
+// list returns a snapshot of the registered hooks, so that a hook can unregister
+// itself (or another hook) without deadlocking.
+func (r *hookRegistry[E]) list() []func(E) {
+	if r == nil {
+		return nil
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if len(r.hooks) == 0 {
+		return nil
+	}
+
+	return mAp(r.hooks, func(item registeredHook[E], _ int) func(E) {
+		return item.hook
+	})
+}
+
+// isEmpty is used to skip building events when nobody is listening.
+func (r *hookRegistry[E]) isEmpty() bool {
+	if r == nil {
+		return true
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return len(r.hooks) == 0
+}
+
+func (r *hookRegistry[E]) copy() *hookRegistry[E] {
+	if r == nil {
+		return nil
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return &hookRegistry[E]{
+		mu:    sync.RWMutex{},
+		seq:   r.seq,
+		hooks: append([]registeredHook[E]{}, r.hooks...),
+	}
+}
+
+// middlewareRegistry holds the invocation middlewares. Middlewares can be added at runtime,
+// while services are being invoked.
+type middlewareRegistry struct {
+	mu          sync.RWMutex
+	middlewares []func(Resolver) Resolver
+}
+
+func newMiddlewareRegistry(middlewares []func(Resolver) Resolver) *middlewareRegistry {
+	return &middlewareRegistry{
+		mu:          sync.RWMutex{},
+		middlewares: append([]func(Resolver) Resolver{}, middlewares...),
+	}
+}
+
+func (r *middlewareRegistry) add(middleware func(Resolver) Resolver) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.middlewares = append(r.middlewares, middleware)
+}
+
+// list returns a snapshot of the registered middlewares.
+func (r *middlewareRegistry) list() []func(Resolver) Resolver {
+	if r == nil {
+		return nil
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.middlewares[:len(r.middlewares):len(r.middlewares)]
+}
+
+func (r *middlewareRegistry) copy() *middlewareRegistry {
+	return newMiddlewareRegistry(r.list())
+}
+
+// newRegistrationEvent builds the event sent to registration event hooks.
+func newRegistrationEvent(scope *Scope, serviceName string, serviceAny any) RegistrationEvent {
+	event := RegistrationEvent{
+		Scope:       scope,
+		ServiceName: serviceName,
+	}
+
+	if service, ok := serviceAny.(serviceWrapperAny); ok {
+		event.ServiceType = service.getServiceType()
+		event.ReflectType = service.getReflectType()
+		event.ProviderFrame, _ = service.source()
+	}
+
+	return event
+}
+
+// newHealthCheckEvent builds the event sent to health check event hooks.
+func newHealthCheckEvent(scope *Scope, serviceName string, serviceAny any, kind HealthCheckKind, duration time.Duration, err error) HealthCheckEvent {
+	event := HealthCheckEvent{
+		Scope:       scope,
+		ServiceName: serviceName,
+		Kind:        kind,
+		Duration:    duration,
+		Error:       err,
+	}
+
+	if service, ok := serviceAny.(serviceWrapperAny); ok {
+		event.ServiceType = service.getServiceType()
+		event.ReflectType = service.getReflectType()
+	}
+
+	return event
+}
+
+// newShutdownEvent builds the event sent to shutdown event hooks.
+func newShutdownEvent(scope *Scope, serviceName string, serviceAny any, duration time.Duration, err error) ShutdownEvent {
+	event := ShutdownEvent{
+		Scope:       scope,
+		ServiceName: serviceName,
+		Duration:    duration,
+		Error:       err,
+	}
+
+	if service, ok := serviceAny.(serviceWrapperAny); ok {
+		event.ServiceType = service.getServiceType()
+		event.ReflectType = service.getReflectType()
+	}
+
+	return event
+}
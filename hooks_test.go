@@ -0,0 +1,312 @@
+package do
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHookRegistry(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	result := ""
+
+	registry := newHookRegistry([]func(string){
+		func(s string) { result += "a" + s },
+	})
+	is.False(registry.isEmpty())
+	is.Len(registry.list(), 1)
+
+	unregister := registry.add(func(s string) { result += "b" + s })
+	is.Len(registry.list(), 2)
+
+	for _, hook := range registry.list() {
+		hook("1")
+	}
+	is.Equal("a1b1", result)
+
+	// copies are independent
+	clone := registry.copy()
+	unregister()
+	unregister() // idempotent
+	is.Len(registry.list(), 1)
+	is.Len(clone.list(), 2)
+
+	// ids keep increasing after copy
+	clone.add(func(s string) {})
+	is.Len(clone.list(), 3)
+
+	// nil-safe
+	var nilRegistry *hookRegistry[string]
+	is.True(nilRegistry.isEmpty())
+	is.Nil(nilRegistry.list())
+	is.Nil(nilRegistry.copy())
+
+	is.True(newHookRegistry[string](nil).isEmpty())
+}
+
+func TestHookRegistry_concurrency(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	registry := newHookRegistry[int](nil)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			unregister := registry.add(func(int) {})
+			_ = registry.list()
+			unregister()
+		}()
+	}
+	wg.Wait()
+
+	is.True(registry.isEmpty())
+}
+
+func TestRegistrationEvent(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	events := []RegistrationEvent{}
+
+	i := NewWithOpts(&InjectorOpts{
+		HookRegistrationEvent: []func(RegistrationEvent){
+			func(event RegistrationEvent) { events = append(events, event) },
+		},
+	})
+
+	Provide(i, func(i Injector) (*lazyTest, error) { return &lazyTest{}, nil })
+	ProvideNamedValue(i, "value", 42)
+	scope := i.Scope("child")
+	ProvideTransient(scope, func(i Injector) (*eagerTest, error) { return &eagerTest{}, nil })
+
+	is.Len(events, 3)
+
+	is.Equal(i.self, events[0].Scope)
+	is.Equal(NameOf[*lazyTest](), events[0].ServiceName)
+	is.Equal(ServiceTypeLazy, events[0].ServiceType)
+	is.Equal(reflect.TypeOf(&lazyTest{}), events[0].ReflectType)
+	is.NotEmpty(events[0].ProviderFrame.File)
+
+	is.Equal("value", events[1].ServiceName)
+	is.Equal(ServiceTypeEager, events[1].ServiceType)
+	is.Equal(reflect.TypeOf(42), events[1].ReflectType)
+
+	is.Equal(scope, events[2].Scope)
+	is.Equal(ServiceTypeTransient, events[2].ServiceType)
+
+	// unregister
+	unregister := i.AddRegistrationEventHook(func(event RegistrationEvent) { events = append(events, event) })
+	ProvideNamedValue(i, "value-2", 42)
+	is.Len(events, 5)
+
+	unregister()
+	ProvideNamedValue(i, "value-3", 42)
+	is.Len(events, 6)
+}
+
+func TestInvocationEvent(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	events := []InvocationEvent{}
+
+	i := New()
+	unregister := i.AddInvocationEventHook(func(event InvocationEvent) { events = append(events, event) })
+
+	Provide(i, func(i Injector) (*lazyTest, error) {
+		time.Sleep(5 * time.Millisecond)
+		return &lazyTest{}, nil
+	})
+	ProvideNamed(i, "parent", func(i Injector) (int, error) {
+		_, err := Invoke[*lazyTest](i)
+		return 42, err
+	})
+	ProvideNamedTransient(i, "transient", func(i Injector) (string, error) { return "foobar", nil })
+	ProvideNamed(i, "ko", func(i Injector) (float64, error) { return 0, assert.AnError })
+
+	// first invocation builds the service and its dependency
+	_, err := InvokeNamed[int](i, "parent")
+	is.NoError(err)
+	is.Len(events, 2)
+
+	is.Equal(NameOf[*lazyTest](), events[0].ServiceName)
+	is.Equal([]string{"parent", NameOf[*lazyTest]()}, events[0].InvokerChain)
+	is.True(events[0].Built)
+	is.GreaterOrEqual(events[0].Duration, 5*time.Millisecond)
+	is.Equal(ServiceTypeLazy, events[0].ServiceType)
+	is.Equal(reflect.TypeOf(&lazyTest{}), events[0].ReflectType)
+	is.NotEmpty(events[0].ProviderFrame.File)
+	is.NoError(events[0].Error)
+
+	is.Equal("parent", events[1].ServiceName)
+	is.Equal([]string{"parent"}, events[1].InvokerChain)
+	is.True(events[1].Built)
+	is.Equal(i.self, events[1].Scope)
+
+	// cache hit
+	_, err = Invoke[*lazyTest](i)
+	is.NoError(err)
+	is.Len(events, 3)
+	is.False(events[2].Built)
+	is.Equal([]string{NameOf[*lazyTest]()}, events[2].InvokerChain)
+
+	// transient services are built on each invocation
+	_, _ = InvokeNamed[string](i, "transient")
+	_, _ = InvokeNamed[string](i, "transient")
+	is.Len(events, 5)
+	is.True(events[3].Built)
+	is.True(events[4].Built)
+
+	// errors
+	_, err = InvokeNamed[float64](i, "ko")
+	is.ErrorIs(err, assert.AnError)
+	is.Len(events, 6)
+	is.ErrorIs(events[5].Error, assert.AnError)
+	is.False(events[5].Built)
+
+	// InvokeAs reports the real service name
+	_, err = InvokeAs[*lazyTest](i)
+	is.NoError(err)
+	is.Len(events, 7)
+	is.Equal(NameOf[*lazyTest](), events[6].ServiceName)
+
+	unregister()
+	_, _ = Invoke[*lazyTest](i)
+	is.Len(events, 7)
+}
+
+func TestShutdownEvent(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	events := []ShutdownEvent{}
+
+	i := NewWithOpts(&InjectorOpts{
+		HookShutdownEvent: []func(ShutdownEvent){
+			func(event ShutdownEvent) { events = append(events, event) },
+		},
+	})
+
+	ProvideNamedValue(i, "ok", &lazyTestShutdownerOK{})
+	ProvideNamedValue(i, "ko", &lazyTestShutdownerKO{})
+
+	err := ShutdownNamed(i, "ok")
+	is.NoError(err)
+	is.Len(events, 1)
+	is.Equal("ok", events[0].ServiceName)
+	is.Equal(i.self, events[0].Scope)
+	is.Equal(ServiceTypeEager, events[0].ServiceType)
+	is.Equal(reflect.TypeOf(&lazyTestShutdownerOK{}), events[0].ReflectType)
+	is.NoError(events[0].Error)
+
+	err = ShutdownNamed(i, "ko")
+	is.Error(err)
+	is.Len(events, 2)
+	is.Equal("ko", events[1].ServiceName)
+	is.Error(events[1].Error)
+}
+
+func TestHookPanicIsolation(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	var mu sync.Mutex
+	logs := []string{}
+	calls := 0
+
+	i := NewWithOpts(&InjectorOpts{
+		HookAfterRegistration: []func(*Scope, string){
+			func(*Scope, string) { panic("registration") },
+		},
+		HookBeforeInvocation: []func(*Scope, string){
+			func(*Scope, string) { panic(errors.New("invocation")) },
+		},
+		HookInvocationEvent: []func(InvocationEvent){
+			func(InvocationEvent) { panic("event") },
+			func(InvocationEvent) { calls++ },
+		},
+		HookAfterShutdown: []func(*Scope, string, error){
+			func(*Scope, string, error) { panic("shutdown") },
+		},
+		Logf: func(format string, args ...any) {
+			mu.Lock()
+			defer mu.Unlock()
+			logs = append(logs, format)
+		},
+	})
+
+	is.NotPanics(func() {
+		ProvideNamedValue(i, "foobar", 42)
+	})
+
+	is.NotPanics(func() {
+		v, err := InvokeNamed[int](i, "foobar")
+		is.NoError(err)
+		is.Equal(42, v)
+	})
+	is.Equal(1, calls)
+
+	is.NotPanics(func() {
+		is.True(i.ShutdownWithContext(context.Background()).Succeed)
+	})
+
+	mu.Lock()
+	defer mu.Unlock()
+	is.Contains(logs, "DI: %s hook panicked for service %s: %v")
+}
+
+func TestHealthCheckEvent(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	var mu sync.Mutex
+	events := []HealthCheckEvent{}
+
+	i := New()
+	unregister := i.AddHealthCheckEventHook(func(event HealthCheckEvent) {
+		mu.Lock()
+		defer mu.Unlock()
+		events = append(events, event)
+	})
+
+	ProvideNamedValue(i, "ok", &lazyTestHeathcheckerOK{})
+	ProvideNamedValue(i, "ko", &lazyTestHeathcheckerKO{})
+
+	is.NoError(HealthCheckNamed(i, "ok"))
+	is.Error(HealthCheckNamed(i, "ko"))
+
+	mu.Lock()
+	is.Len(events, 2)
+	is.Equal("ok", events[0].ServiceName)
+	is.Equal(i.self, events[0].Scope)
+	is.Equal(ServiceTypeEager, events[0].ServiceType)
+	is.Equal(reflect.TypeOf(&lazyTestHeathcheckerOK{}), events[0].ReflectType)
+	is.NoError(events[0].Error)
+	is.Equal("ko", events[1].ServiceName)
+	is.Error(events[1].Error)
+	mu.Unlock()
+
+	unregister()
+	_ = HealthCheckNamed(i, "ok")
+
+	mu.Lock()
+	is.Len(events, 2)
+	mu.Unlock()
+}
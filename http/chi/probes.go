@@ -0,0 +1,52 @@
+package dochi
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/samber/do/v2"
+	dohttp "github.com/samber/do/v2/http"
+)
+
+// UseProbes registers Kubernetes-style health probes on a Chi router.
+//
+// Parameters:
+//   - router: The Chi router to register the routes on
+//   - basePath: The base URL path of the probes (e.g., "" or "/health")
+//   - injector: The injector instance to check
+//
+// The function sets up the following routes:
+//   - GET {basePath}/livez, /readyz, /startupz: Run the probe on every service
+//   - GET {basePath}/livez/{name}, /readyz/{name}, /startupz/{name}: Run the probe on a single service
+//
+// The `exclude` query parameter can be repeated to skip services. Responses are JSON
+// documents, with a 200 status code when every check succeeded and 503 otherwise.
+//
+// Example:
+//
+//	router := chi.NewRouter()
+//	dochi.UseProbes(router, "", injector)
+func UseProbes(router *chi.Mux, basePath string, injector do.Injector) {
+	probes := dohttp.NewProbeHandler(injector)
+
+	for _, probe := range dohttp.Probes {
+		probe := probe
+		path := basePath + "/" + string(probe)
+
+		router.Get(path, func(w http.ResponseWriter, r *http.Request) {
+			status, body := probes.Run(r.Context(), probe, "", r.URL.Query()["exclude"])
+			probeResponse(w, status, body)
+		})
+
+		router.Get(path+"/{name}", func(w http.ResponseWriter, r *http.Request) {
+			status, body := probes.Run(r.Context(), probe, chi.URLParam(r, "name"), r.URL.Query()["exclude"])
+			probeResponse(w, status, body)
+		})
+	}
+}
+
+func probeResponse(w http.ResponseWriter, status int, body []byte) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(body)
+}
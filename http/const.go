@@ -16,4 +16,10 @@ const (
 	keyScopes           = "Scopes"
 	keyServiceTypeIcon  = "ServiceTypeIcon"
 	keyFeaturesIcons    = "FeaturesIcons"
+	keyHealth           = "Health"
+	keyRunner           = "Runner"
+	keyShutdownPlan     = "ShutdownPlan"
+	keyBuildPlan        = "BuildPlan"
+	keyCycle            = "Cycle"
+	keyText             = "Text"
 )
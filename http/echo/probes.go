@@ -0,0 +1,43 @@
+package doecho
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/samber/do/v2"
+	dohttp "github.com/samber/do/v2/http"
+)
+
+// UseProbes registers Kubernetes-style health probes on an Echo router group.
+//
+// Parameters:
+//   - router: The Echo router group to register the routes on
+//   - injector: The injector instance to check
+//
+// The function sets up the following routes:
+//   - GET /livez, /readyz, /startupz: Run the probe on every service
+//   - GET /livez/:name, /readyz/:name, /startupz/:name: Run the probe on a single service
+//
+// The `exclude` query parameter can be repeated to skip services. Responses are JSON
+// documents, with a 200 status code when every check succeeded and 503 otherwise.
+//
+// Example:
+//
+//	e := echo.New()
+//	doecho.UseProbes(e.Group(""), injector)
+func UseProbes(router *echo.Group, injector do.Injector) {
+	probes := dohttp.NewProbeHandler(injector)
+
+	for _, probe := range dohttp.Probes {
+		probe := probe
+		path := "/" + string(probe)
+
+		router.GET(path, func(c echo.Context) error {
+			status, body := probes.Run(c.Request().Context(), probe, "", c.QueryParams()["exclude"])
+			return c.JSONBlob(status, body)
+		})
+
+		router.GET(path+"/:name", func(c echo.Context) error {
+			status, body := probes.Run(c.Request().Context(), probe, c.Param("name"), c.QueryParams()["exclude"])
+			return c.JSONBlob(status, body)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package dofiber
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/samber/do/v2"
+	dohttp "github.com/samber/do/v2/http"
+)
+
+// UseProbes registers Kubernetes-style health probes on a Fiber router.
+//
+// Parameters:
+//   - router: The Fiber router to register the routes on
+//   - injector: The injector instance to check
+//
+// The function sets up the following routes:
+//   - GET /livez, /readyz, /startupz: Run the probe on every service
+//   - GET /livez/:name, /readyz/:name, /startupz/:name: Run the probe on a single service
+//
+// The `exclude` query parameter can be repeated to skip services. Responses are JSON
+// documents, with a 200 status code when every check succeeded and 503 otherwise.
+//
+// Example:
+//
+//	app := fiber.New()
+//	dofiber.UseProbes(app, injector)
+func UseProbes(router fiber.Router, injector do.Injector) {
+	probes := dohttp.NewProbeHandler(injector)
+
+	for _, probe := range dohttp.Probes {
+		probe := probe
+		path := "/" + string(probe)
+
+		router.Get(path, func(c *fiber.Ctx) error {
+			return probeResponse(c, probes, probe, "")
+		})
+
+		router.Get(path+"/:name", func(c *fiber.Ctx) error {
+			return probeResponse(c, probes, probe, c.Params("name"))
+		})
+	}
+}
+
+func probeResponse(c *fiber.Ctx, probes *dohttp.ProbeHandler, probe dohttp.Probe, check string) error {
+	exclude := []string{}
+	for _, value := range c.Context().QueryArgs().PeekMulti("exclude") {
+		exclude = append(exclude, string(value))
+	}
+
+	status, body := probes.Run(c.UserContext(), probe, check, exclude)
+
+	c.Response().Header.Set("Content-Type", "application/json")
+	return c.Status(status).Send(body)
+}
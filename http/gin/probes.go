@@ -0,0 +1,45 @@
+package gin
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/samber/do/v2"
+	dohttp "github.com/samber/do/v2/http"
+)
+
+// UseProbes registers Kubernetes-style health probes on a Gin router group.
+//
+// Parameters:
+//   - router: The Gin router group to register the routes on
+//   - injector: The injector instance to check
+//
+// The function sets up the following routes:
+//   - GET /livez, /readyz, /startupz: Run the probe on every service
+//   - GET /livez/:name, /readyz/:name, /startupz/:name: Run the probe on a single service
+//
+// The `exclude` query parameter can be repeated to skip services. Responses are JSON
+// documents, with a 200 status code when every check succeeded and 503 otherwise.
+//
+// Example:
+//
+//	import dogin "github.com/samber/do/http/gin/v2" // the package is named gin, like the Gin framework
+//
+//	router := gin.New()
+//	dogin.UseProbes(router.Group(""), injector)
+func UseProbes(router *gin.RouterGroup, injector do.Injector) {
+	probes := dohttp.NewProbeHandler(injector)
+
+	for _, probe := range dohttp.Probes {
+		probe := probe
+		path := "/" + string(probe)
+
+		router.Handle("GET", path, func(c *gin.Context) {
+			status, body := probes.Run(c.Request.Context(), probe, "", c.QueryArray("exclude"))
+			c.Data(status, "application/json", body)
+		})
+
+		router.Handle("GET", path+"/:name", func(c *gin.Context) {
+			status, body := probes.Run(c.Request.Context(), probe, c.Param("name"), c.QueryArray("exclude"))
+			c.Data(status, "application/json", body)
+		})
+	}
+}
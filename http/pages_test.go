@@ -1,8 +1,11 @@
 package dohttp
 
 import (
+	"context"
+	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	do "github.com/samber/do/v2"
 	"github.com/stretchr/testify/assert"
@@ -42,6 +45,34 @@ func TestScopeTreeHTML_Basic(t *testing.T) {
 	is.True(strings.Contains(html, "db-service") || strings.Contains(html, "cache-service"))
 }
 
+func TestScopeTreeHTML_Plans(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	root := do.New()
+	child := root.Scope("child")
+
+	do.ProvideNamedValue(root, "db", "db-value")
+	do.ProvideNamed(child, "repository", func(i do.Injector) (int, error) {
+		_ = do.MustInvokeNamed[string](i, "db")
+		return 42, nil
+	})
+	_ = do.MustInvokeNamed[int](child, "repository")
+
+	html, err := ScopeTreeHTML("/debug/di", root, root.ID())
+	is.NoError(err)
+	is.Contains(html, "Shutdown plan")
+	is.Contains(html, "  wave 1: child &gt; repository\n  wave 2: [root] &gt; db\n  cross-scope dependency: child &gt; repository depends on [root] &gt; db")
+	is.Contains(html, "Build plan")
+	is.Contains(html, "wave 1: [root] &gt; db\nwave 2: child &gt; repository")
+	is.NotContains(html, "Circular dependency")
+
+	// the shutdown plan of the selected scope
+	html, err = ScopeTreeHTML("/debug/di", root, child.ID())
+	is.NoError(err)
+	is.Contains(html, "<pre>child (ID: "+child.ID()+")\n  wave 1: child &gt; repository</pre>")
+}
+
 func TestServiceListHTML_Basic(t *testing.T) {
 	t.Parallel()
 	is := assert.New(t)
@@ -74,3 +105,55 @@ func TestServiceHTML_Basic(t *testing.T) {
 	// eager value should report eager type
 	is.Contains(html, "Service type: eager")
 }
+
+func TestServiceHTML_Health(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	basePath := "/debug/di"
+	root := do.New()
+	do.ProvideNamedValue(root, "db", &probeTestHealthchecker{err: errors.New("connection refused")})
+
+	html, err := ServiceHTML(basePath, root, root.ID(), "db")
+	is.NoError(err)
+	is.NotContains(html, "Health:")
+
+	monitor := root.StartHealthMonitor(do.HealthMonitorOpts{Interval: time.Hour})
+	defer monitor.Stop()
+	monitor.Check(context.Background())
+
+	html, err = ServiceHTML(basePath, root, root.ID(), "db")
+	is.NoError(err)
+	is.Contains(html, "Health:")
+	is.Contains(html, "health: ❌ unhealthy: connection refused")
+}
+
+type pagesTestRunner struct{}
+
+func (r *pagesTestRunner) Run(ctx context.Context) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestServiceHTML_Runner(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	basePath := "/debug/di"
+	root := do.New()
+	do.ProvideNamedValue(root, "consumer", &pagesTestRunner{}, do.WithRestartPolicy(do.RestartPolicyOnFailure))
+
+	html, err := ServiceHTML(basePath, root, root.ID(), "consumer")
+	is.NoError(err)
+	is.NotContains(html, "Runner:")
+
+	_ = do.MustInvokeNamed[*pagesTestRunner](root, "consumer")
+
+	html, err = ServiceHTML(basePath, root, root.ID(), "consumer")
+	is.NoError(err)
+	is.Contains(html, "Runner:")
+	is.Contains(html, "running, since")
+	is.Contains(html, "(restart policy: on-failure, restarts: 0)")
+
+	is.True(root.Shutdown().Succeed)
+}
@@ -0,0 +1,268 @@
+package dohttp
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/samber/do/v2"
+)
+
+// Probe is the kind of a Kubernetes-style health probe.
+type Probe string
+
+const (
+	// ProbeLiveness reports whether the application is alive. A failure should restart the process.
+	ProbeLiveness Probe = "livez"
+	// ProbeReadiness reports whether the application can serve traffic.
+	ProbeReadiness Probe = "readyz"
+	// ProbeStartup reports whether the application has started. Once it succeeded, it keeps succeeding.
+	ProbeStartup Probe = "startupz"
+)
+
+// Probes lists the supported probes.
+var Probes = []Probe{ProbeLiveness, ProbeReadiness, ProbeStartup}
+
+// Status of a probe or of a service check.
+const (
+	ProbeStatusOK    = "ok"
+	ProbeStatusError = "error"
+)
+
+// ProbeCheckOutput is the status of a service in a probe response.
+type ProbeCheckOutput struct {
+	ScopeID   string     `json:"scope_id"`
+	ScopeName string     `json:"scope_name"`
+	Service   string     `json:"service"`
+	Status    string     `json:"status"`
+	LatencyMs float64    `json:"latency_ms"`
+	Error     string     `json:"error,omitempty"`
+	CheckedAt *time.Time `json:"checked_at,omitempty"` // Only set for results cached by the health monitor
+}
+
+// ProbeOutput is the JSON body of a probe response.
+type ProbeOutput struct {
+	Probe  Probe              `json:"probe"`
+	Status string             `json:"status"`
+	Checks []ProbeCheckOutput `json:"checks"`
+}
+
+// ProbeChecker checks a single service, registered in the provided scope.
+type ProbeChecker func(ctx context.Context, scope do.Injector, serviceName string) error
+
+// ProbeHandler runs the liveness, readiness and startup probes of an injector.
+// It is framework agnostic: the chi, echo, fiber, gin and std adapters call Run
+// and write the returned status code and body.
+type ProbeHandler struct {
+	injector do.Injector
+	checkers map[Probe]ProbeChecker
+	started  int32
+}
+
+// NewProbeHandler creates the probes of an injector, in the scope tree of the injector:
+//   - the liveness probe runs the liveness checks (see do.LivenessChecker)
+//   - the readiness and startup probes run the readiness checks (see do.ReadinessChecker)
+//
+// Healthcheckers are checked by every probe.
+//
+// When a health monitor runs the matching kind of checks (see do.RootScope.StartHealthMonitor),
+// the probes read its cached results instead of running the checks on every request.
+func NewProbeHandler(injector do.Injector) *ProbeHandler {
+	return &ProbeHandler{
+		injector: injector,
+		checkers: map[Probe]ProbeChecker{
+			ProbeLiveness:  do.LivenessCheckNamedWithContext,
+			ProbeReadiness: do.ReadinessCheckNamedWithContext,
+			ProbeStartup:   do.ReadinessCheckNamedWithContext,
+		},
+		started: 0,
+	}
+}
+
+// Run executes a probe, and returns the HTTP status code and the JSON body of the response.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout control
+//   - probe: The probe to run
+//   - check: When not empty, only the service with this name is checked (404 when not found)
+//   - exclude: Names of services that must not be checked
+//
+// The status code is 200 when every check succeeded, 503 otherwise.
+func (h *ProbeHandler) Run(ctx context.Context, probe Probe, check string, exclude []string) (int, []byte) {
+	checker, ok := h.checkers[probe]
+	if !ok {
+		return h.response(http.StatusNotFound, ProbeOutput{Probe: probe, Status: ProbeStatusError, Checks: []ProbeCheckOutput{}})
+	}
+
+	// The startup probe is latched: once the application started, it is not checked anymore.
+	if probe == ProbeStartup && check == "" && atomic.LoadInt32(&h.started) == 1 {
+		return h.response(http.StatusOK, ProbeOutput{Probe: probe, Status: ProbeStatusOK, Checks: []ProbeCheckOutput{}})
+	}
+
+	targets := listProbeTargets(h.injector, probe, check, exclude)
+	if check != "" && len(targets) == 0 {
+		return h.response(http.StatusNotFound, ProbeOutput{Probe: probe, Status: ProbeStatusError, Checks: []ProbeCheckOutput{}})
+	}
+
+	output := ProbeOutput{
+		Probe:  probe,
+		Status: ProbeStatusOK,
+		Checks: runProbeChecks(ctx, h.injector.RootScope(), checker, targets, h.cache(probe)),
+	}
+
+	for _, c := range output.Checks {
+		if c.Status != ProbeStatusOK {
+			output.Status = ProbeStatusError
+		}
+	}
+
+	if output.Status != ProbeStatusOK {
+		return h.response(http.StatusServiceUnavailable, output)
+	}
+
+	if probe == ProbeStartup && check == "" && len(exclude) == 0 {
+		atomic.StoreInt32(&h.started, 1)
+	}
+
+	return h.response(http.StatusOK, output)
+}
+
+func (h *ProbeHandler) response(status int, output ProbeOutput) (int, []byte) {
+	body, err := json.Marshal(output)
+	if err != nil {
+		return http.StatusInternalServerError, []byte(`{"status":"error"}`)
+	}
+
+	return status, body
+}
+
+// cache returns the snapshot of the health monitor of the injector, when it runs the checks of the probe.
+func (h *ProbeHandler) cache(probe Probe) func(probeTarget) (do.HealthStatus, bool) {
+	noCache := func(probeTarget) (do.HealthStatus, bool) { return do.HealthStatus{}, false }
+
+	monitor, ok := h.injector.RootScope().HealthMonitor()
+	if !ok {
+		return noCache
+	}
+
+	kind := do.HealthCheckKindReadiness
+	if probe == ProbeLiveness {
+		kind = do.HealthCheckKindLiveness
+	}
+
+	if !monitor.Monitors(kind) {
+		return noCache
+	}
+
+	snapshot := monitor.Snapshot()
+	if snapshot.CheckedAt.IsZero() {
+		return noCache
+	}
+
+	return func(target probeTarget) (do.HealthStatus, bool) {
+		return snapshot.Get(target.scope.ScopeID, target.service, kind)
+	}
+}
+
+type probeTarget struct {
+	scope   do.ExplainInjectorScopeOutput
+	service string
+}
+
+// listProbeTargets lists the services checked by a probe, in the scope tree.
+func listProbeTargets(injector do.Injector, probe Probe, check string, exclude []string) []probeTarget {
+	excluded := map[string]struct{}{}
+	for _, name := range exclude {
+		excluded[name] = struct{}{}
+	}
+
+	targets := []probeTarget{}
+
+	for _, scope := range getAllScopes(injector) {
+		for _, service := range scope.Services {
+			if !isProbed(probe, service) {
+				continue
+			}
+			if check != "" && service.ServiceName != check {
+				continue
+			}
+			if _, ok := excluded[service.ServiceName]; ok {
+				continue
+			}
+
+			targets = append(targets, probeTarget{scope: scope, service: service.ServiceName})
+		}
+	}
+
+	return targets
+}
+
+func isProbed(probe Probe, service do.ExplainInjectorServiceOutput) bool {
+	if probe == ProbeLiveness {
+		return service.IsLivenessChecker
+	}
+
+	return service.IsReadinessChecker
+}
+
+// runProbeChecks checks the services in parallel, and measures the latency of each check.
+// Services found in the cache are not checked again. Checks are queued in the health check
+// pool of the injector, so that the HealthCheckTimeout and HealthCheckParallelism options apply.
+func runProbeChecks(ctx context.Context, root *do.RootScope, checker ProbeChecker, targets []probeTarget, cache func(probeTarget) (do.HealthStatus, bool)) []ProbeCheckOutput {
+	results := make([]ProbeCheckOutput, len(targets))
+
+	var wg sync.WaitGroup
+	for i := range targets {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			target := targets[i]
+
+			var err error
+			var latency time.Duration
+			var checkedAt *time.Time
+
+			if status, ok := cache(target); ok {
+				err = status.Error
+				latency = status.Latency
+				checkedAt = &status.CheckedAt
+			} else {
+				start := time.Now()
+				err = <-root.QueueHealthCheck(ctx, func(ctx context.Context) error {
+					return checker(ctx, target.scope.Scope, target.service)
+				})
+				latency = time.Since(start)
+			}
+
+			results[i] = ProbeCheckOutput{
+				ScopeID:   target.scope.ScopeID,
+				ScopeName: target.scope.ScopeName,
+				Service:   target.service,
+				Status:    ProbeStatusOK,
+				LatencyMs: float64(latency.Microseconds()) / 1000,
+				CheckedAt: checkedAt,
+			}
+
+			if err != nil {
+				results[i].Status = ProbeStatusError
+				results[i].Error = err.Error()
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].ScopeName != results[j].ScopeName {
+			return results[i].ScopeName < results[j].ScopeName
+		}
+		return results[i].Service < results[j].Service
+	})
+
+	return results
+}
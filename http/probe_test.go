@@ -0,0 +1,253 @@
+package dohttp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	do "github.com/samber/do/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+type probeTestHealthchecker struct {
+	err error
+}
+
+func (h *probeTestHealthchecker) HealthCheck() error { return h.err }
+
+type probeTestWarmingUp struct{}
+
+func (h *probeTestWarmingUp) ReadinessCheck(context.Context) error { return errors.New("warming up") }
+func (h *probeTestWarmingUp) LivenessCheck(context.Context) error  { return nil }
+
+type probeTestReadinessOnly struct{}
+
+func (h *probeTestReadinessOnly) ReadinessCheck(context.Context) error { return nil }
+
+type probeTestConcurrency struct {
+	running *int32
+	max     *int32
+}
+
+func (h *probeTestConcurrency) ReadinessCheck(context.Context) error {
+	running := atomic.AddInt32(h.running, 1)
+	defer atomic.AddInt32(h.running, -1)
+
+	for {
+		current := atomic.LoadInt32(h.max)
+		if running <= current || atomic.CompareAndSwapInt32(h.max, current, running) {
+			break
+		}
+	}
+
+	time.Sleep(5 * time.Millisecond)
+	return nil
+}
+
+type probeTestStuck struct{}
+
+func (h *probeTestStuck) ReadinessCheck(ctx context.Context) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func runProbe(t *testing.T, h *ProbeHandler, probe Probe, check string, exclude []string) (int, ProbeOutput) {
+	t.Helper()
+
+	status, body := h.Run(context.Background(), probe, check, exclude)
+
+	var output ProbeOutput
+	assert.NoError(t, json.Unmarshal(body, &output))
+
+	return status, output
+}
+
+func TestProbeHandler_Run(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	root := do.New()
+	child := root.Scope("child")
+
+	do.ProvideNamedValue(root, "db", &probeTestHealthchecker{})
+	do.ProvideNamedValue(child, "cache", &probeTestHealthchecker{err: errors.New("connection refused")})
+	do.ProvideNamedValue(root, "config", "not a healthchecker")
+
+	h := NewProbeHandler(root)
+
+	status, output := runProbe(t, h, ProbeReadiness, "", nil)
+	is.Equal(http.StatusServiceUnavailable, status)
+	is.Equal(ProbeReadiness, output.Probe)
+	is.Equal(ProbeStatusError, output.Status)
+	is.Len(output.Checks, 2)
+	is.Equal("db", output.Checks[0].Service)
+	is.Equal("[root]", output.Checks[0].ScopeName)
+	is.Equal(root.ID(), output.Checks[0].ScopeID)
+	is.Equal(ProbeStatusOK, output.Checks[0].Status)
+	is.Empty(output.Checks[0].Error)
+	is.GreaterOrEqual(output.Checks[0].LatencyMs, 0.0)
+	is.Equal("cache", output.Checks[1].Service)
+	is.Equal("child", output.Checks[1].ScopeName)
+	is.Equal(ProbeStatusError, output.Checks[1].Status)
+	is.Equal("connection refused", output.Checks[1].Error)
+
+	// exclude
+	status, output = runProbe(t, h, ProbeLiveness, "", []string{"cache"})
+	is.Equal(http.StatusOK, status)
+	is.Equal(ProbeStatusOK, output.Status)
+	is.Len(output.Checks, 1)
+
+	// single check
+	status, output = runProbe(t, h, ProbeReadiness, "db", nil)
+	is.Equal(http.StatusOK, status)
+	is.Len(output.Checks, 1)
+	is.Equal("db", output.Checks[0].Service)
+
+	status, output = runProbe(t, h, ProbeReadiness, "cache", nil)
+	is.Equal(http.StatusServiceUnavailable, status)
+	is.Len(output.Checks, 1)
+
+	status, _ = runProbe(t, h, ProbeReadiness, "config", nil)
+	is.Equal(http.StatusNotFound, status)
+	status, _ = runProbe(t, h, ProbeReadiness, "unknown", nil)
+	is.Equal(http.StatusNotFound, status)
+
+	// unknown probe
+	status, _ = runProbe(t, h, Probe("foobar"), "", nil)
+	is.Equal(http.StatusNotFound, status)
+}
+
+func TestProbeHandler_Run_startup(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	root := do.New()
+	service := &probeTestHealthchecker{err: errors.New("starting")}
+	do.ProvideNamedValue(root, "db", service)
+
+	h := NewProbeHandler(root)
+
+	status, _ := runProbe(t, h, ProbeStartup, "", nil)
+	is.Equal(http.StatusServiceUnavailable, status)
+
+	// a partial success does not latch the probe
+	service.err = nil
+	status, _ = runProbe(t, h, ProbeStartup, "", []string{"db"})
+	is.Equal(http.StatusOK, status)
+
+	service.err = errors.New("starting")
+	status, _ = runProbe(t, h, ProbeStartup, "", nil)
+	is.Equal(http.StatusServiceUnavailable, status)
+
+	service.err = nil
+	status, output := runProbe(t, h, ProbeStartup, "", nil)
+	is.Equal(http.StatusOK, status)
+	is.Len(output.Checks, 1)
+
+	// latched
+	service.err = errors.New("broken")
+	status, output = runProbe(t, h, ProbeStartup, "", nil)
+	is.Equal(http.StatusOK, status)
+	is.Empty(output.Checks)
+
+	status, _ = runProbe(t, h, ProbeLiveness, "", nil)
+	is.Equal(http.StatusServiceUnavailable, status)
+}
+
+func TestProbeHandler_Run_healthCheckOptions(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	// the checks are bounded by HealthCheckParallelism
+	root := do.NewWithOpts(&do.InjectorOpts{HealthCheckParallelism: 2})
+	var running, maxRunning int32
+	for i := 0; i < 6; i++ {
+		do.ProvideNamedValue(root, fmt.Sprintf("service-%d", i), &probeTestConcurrency{running: &running, max: &maxRunning})
+	}
+
+	status, output := runProbe(t, NewProbeHandler(root), ProbeReadiness, "", nil)
+	is.Equal(http.StatusOK, status)
+	is.Len(output.Checks, 6)
+	is.LessOrEqual(atomic.LoadInt32(&maxRunning), int32(2))
+
+	// the checks are bounded by HealthCheckTimeout
+	root = do.NewWithOpts(&do.InjectorOpts{HealthCheckTimeout: 10 * time.Millisecond})
+	do.ProvideNamedValue(root, "stuck", &probeTestStuck{})
+
+	status, output = runProbe(t, NewProbeHandler(root), ProbeReadiness, "", nil)
+	is.Equal(http.StatusServiceUnavailable, status)
+	is.Len(output.Checks, 1)
+	is.Equal(ProbeStatusError, output.Checks[0].Status)
+}
+
+func TestProbeHandler_Run_readinessAndLiveness(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	root := do.New()
+	do.ProvideNamedValue(root, "cache", &probeTestWarmingUp{})
+	do.ProvideNamedValue(root, "queue", &probeTestReadinessOnly{})
+
+	h := NewProbeHandler(root)
+
+	status, output := runProbe(t, h, ProbeReadiness, "", nil)
+	is.Equal(http.StatusServiceUnavailable, status)
+	is.Len(output.Checks, 2)
+	is.Equal("cache", output.Checks[0].Service)
+	is.Equal("warming up", output.Checks[0].Error)
+	is.Equal("queue", output.Checks[1].Service)
+	is.Equal(ProbeStatusOK, output.Checks[1].Status)
+
+	// the queue has no liveness check
+	status, output = runProbe(t, h, ProbeLiveness, "", nil)
+	is.Equal(http.StatusOK, status)
+	is.Len(output.Checks, 1)
+	is.Equal("cache", output.Checks[0].Service)
+
+	status, _ = runProbe(t, h, ProbeLiveness, "queue", nil)
+	is.Equal(http.StatusNotFound, status)
+}
+
+func TestProbeHandler_Run_healthMonitor(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	root := do.New()
+	service := &probeTestHealthchecker{}
+	do.ProvideNamedValue(root, "db", service)
+
+	monitor := root.StartHealthMonitor(do.HealthMonitorOpts{
+		Interval: time.Hour,
+		Kinds:    []do.HealthCheckKind{do.HealthCheckKindReadiness},
+	})
+	defer monitor.Stop()
+
+	// wait for the first background run
+	for monitor.Snapshot().CheckedAt.IsZero() {
+		time.Sleep(time.Millisecond)
+	}
+
+	h := NewProbeHandler(root)
+	service.err = errors.New("connection refused")
+
+	// readiness is served from the cache
+	status, output := runProbe(t, h, ProbeReadiness, "", nil)
+	is.Equal(http.StatusOK, status)
+	is.Len(output.Checks, 1)
+	is.NotNil(output.Checks[0].CheckedAt)
+
+	// liveness is not monitored
+	status, output = runProbe(t, h, ProbeLiveness, "", nil)
+	is.Equal(http.StatusServiceUnavailable, status)
+	is.Nil(output.Checks[0].CheckedAt)
+
+	monitor.Check(context.Background())
+	status, output = runProbe(t, h, ProbeReadiness, "", nil)
+	is.Equal(http.StatusServiceUnavailable, status)
+	is.Equal("connection refused", output.Checks[0].Error)
+}
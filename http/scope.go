@@ -1,6 +1,8 @@
 package dohttp
 
 import (
+	"html"
+
 	"github.com/samber/do/v2"
 )
 
@@ -20,6 +22,7 @@ import (
 //   - Service icons indicating their type and capabilities
 //   - Navigation links between different views
 //   - Interactive scope inspection links
+//   - The shutdown plan of the scope, and the build plan of the root scope
 //
 // Example:
 //
@@ -31,6 +34,14 @@ import (
 func ScopeTreeHTML(basePath string, injector do.Injector, scopeID string) (string, error) {
 	description := do.ExplainInjector(injector)
 
+	scope := injector
+	if s, ok := getScopeByID(injector, scopeID); ok && s.Scope != nil {
+		scope = s.Scope
+	}
+
+	shutdownPlan := scope.ShutdownPlan()
+	buildPlan := injector.RootScope().BuildPlan()
+
 	return fromTemplate(
 		`<!DOCTYPE html>
 <html>
@@ -91,6 +102,18 @@ func ScopeTreeHTML(basePath string, injector do.Injector, scopeID string) (strin
 				{{end}}
 			</ul>
 		{{end}}
+
+		<h2>Shutdown plan</h2>
+		{{if .ShutdownPlan.Cycle}}
+			<p>⚠️ Circular dependency: some services would be shut down without taking care of order.</p>
+		{{end}}
+		<pre>{{.ShutdownPlan.Text}}</pre>
+
+		<h2>Build plan</h2>
+		{{if .BuildPlan.Cycle}}
+			<p>⚠️ Circular dependency: some services would be built without taking care of order.</p>
+		{{end}}
+		<pre>{{.BuildPlan.Text}}</pre>
 	</body>
 </html>`,
 		map[string]any{
@@ -98,6 +121,14 @@ func ScopeTreeHTML(basePath string, injector do.Injector, scopeID string) (strin
 			keyScopes: mAp(description.DAG, func(item do.ExplainInjectorScopeOutput) string {
 				return scopeTreeScopeToHTML(basePath, item)
 			}),
+			keyShutdownPlan: map[string]any{
+				keyCycle: shutdownPlan.HasCycle(),
+				keyText:  html.EscapeString(shutdownPlan.String()),
+			},
+			keyBuildPlan: map[string]any{
+				keyCycle: buildPlan.HasCycle(),
+				keyText:  html.EscapeString(buildPlan.String()),
+			},
 		},
 	)
 }
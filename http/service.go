@@ -1,6 +1,9 @@
 package dohttp
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/samber/do/v2"
 )
 
@@ -20,6 +23,8 @@ import (
 //   - Service metadata (scope, type, build time, invocation location)
 //   - List of dependencies with clickable links
 //   - List of dependents with clickable links
+//   - Cached health status, when a health monitor is running
+//   - Runner state, when the service implements do.Runner
 //   - Navigation to other views
 //
 // If the scope or service is not found, it falls back to the service list page.
@@ -82,6 +87,20 @@ func ServiceHTML(basePath string, injector do.Injector, scopeID string, serviceN
 		Invoked at: {{.Invoked}}
 	</p>
 
+	{{if .Health}}
+		<h2>Health:</h2>
+		<ul>
+			{{range .Health}}
+				<li>{{.}}</li>
+			{{end}}
+		</ul>
+	{{end}}
+
+	{{if .Runner}}
+		<h2>Runner:</h2>
+		<p>{{.Runner}}</p>
+	{{end}}
+
 	<h2>Dependencies:</h2>
 	{{.Dependencies}}
 
@@ -99,10 +118,72 @@ func ServiceHTML(basePath string, injector do.Injector, scopeID string, serviceN
 			keyInvoked:          invoked,
 			keyDependencies:     serviceToHTML(basePath, service.Dependencies),
 			keyDependents:       serviceToHTML(basePath, service.Dependents),
+			keyHealth:           serviceHealth(injector, service.ScopeID, service.ServiceName),
+			keyRunner:           serviceRunner(injector, service.ScopeID, service.ServiceName),
 		},
 	)
 }
 
+// serviceHealth describes the cached health status of a service, read from the health monitor.
+// It returns an empty list when no health monitor is running.
+func serviceHealth(injector do.Injector, scopeID string, serviceName string) []string {
+	monitor, ok := injector.RootScope().HealthMonitor()
+	if !ok {
+		return []string{}
+	}
+
+	output := []string{}
+	for _, status := range monitor.Snapshot().Services {
+		if status.ScopeID != scopeID || status.ServiceName != serviceName {
+			continue
+		}
+
+		state := "✅ healthy"
+		if !status.Healthy {
+			state = fmt.Sprintf("❌ unhealthy: %s", status.Error)
+		}
+		if status.Flapping {
+			state += " (flapping)"
+		}
+
+		output = append(output, fmt.Sprintf(
+			"%s: %s, since %s (checked at %s in %s)",
+			status.Kind,
+			state,
+			status.Since.Format(time.RFC3339),
+			status.CheckedAt.Format(time.RFC3339),
+			status.Latency,
+		))
+	}
+
+	return output
+}
+
+// serviceRunner describes the state of a service supervised as a do.Runner. It returns an empty
+// string when the service is not supervised.
+func serviceRunner(injector do.Injector, scopeID string, serviceName string) string {
+	for _, status := range injector.RootScope().Runners() {
+		if status.Service.ScopeID != scopeID || status.Service.Service != serviceName {
+			continue
+		}
+
+		output := fmt.Sprintf(
+			"%s, since %s (restart policy: %s, restarts: %d)",
+			status.State,
+			status.StartedAt.Format(time.RFC3339),
+			status.Policy,
+			status.Restarts,
+		)
+		if status.LastError != nil {
+			output += fmt.Sprintf(", last error: %s", status.LastError)
+		}
+
+		return output
+	}
+
+	return ""
+}
+
 // serviceToHTML converts a list of service dependencies to HTML representation.
 // This function generates clickable links for each service in the dependency list,
 // allowing users to navigate to detailed views of related services.
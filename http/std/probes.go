@@ -0,0 +1,57 @@
+package dohttpstd
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/samber/do/v2"
+	dohttp "github.com/samber/do/v2/http"
+)
+
+// UseProbes creates an HTTP handler serving Kubernetes-style health probes, using the
+// standard Go net/http package.
+//
+// Parameters:
+//   - basePath: The base URL path of the probes (e.g., "" or "/health")
+//   - injector: The injector instance to check
+//
+// Returns an http.Handler that serves the probes.
+//
+// The handler sets up the following routes:
+//   - GET /livez, /readyz, /startupz: Run the probe on every service
+//   - GET /livez/{name}, /readyz/{name}, /startupz/{name}: Run the probe on a single service
+//
+// The `exclude` query parameter can be repeated to skip services. Responses are JSON
+// documents listing the status, latency and error of each service, with a 200 status
+// code when every check succeeded and 503 otherwise.
+//
+// Example:
+//
+//	mux := http.NewServeMux()
+//	mux.Handle("/", dohttpstd.UseProbes("", injector))
+func UseProbes(basePath string, injector do.Injector) http.Handler {
+	probes := dohttp.NewProbeHandler(injector)
+	mux := http.NewServeMux()
+
+	for _, probe := range dohttp.Probes {
+		probe := probe
+		prefix := "/" + string(probe)
+
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			check := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, prefix), "/")
+			status, body := probes.Run(r.Context(), probe, check, r.URL.Query()["exclude"])
+			probeResponse(w, status, body)
+		}
+
+		mux.HandleFunc(prefix, handler)
+		mux.HandleFunc(prefix+"/", handler)
+	}
+
+	return http.StripPrefix(basePath, mux)
+}
+
+func probeResponse(w http.ResponseWriter, status int, body []byte) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(body)
+}
@@ -51,12 +51,51 @@ type Injector interface {
 	// HealthCheckWithContext performs health checks with context support for cancellation and timeouts.
 	HealthCheckWithContext(context.Context) map[string]error
 
+	// ReadinessCheck performs readiness checks on all services in the current scope and its ancestors.
+	ReadinessCheck() map[string]error
+
+	// ReadinessCheckWithContext performs readiness checks with context support for cancellation and timeouts.
+	ReadinessCheckWithContext(context.Context) map[string]error
+
+	// LivenessCheck performs liveness checks on all services in the current scope and its ancestors.
+	LivenessCheck() map[string]error
+
+	// LivenessCheckWithContext performs liveness checks with context support for cancellation and timeouts.
+	LivenessCheckWithContext(context.Context) map[string]error
+
+	// HealthCheckTree performs health checks on all services in the current scope, its ancestors and its descendants.
+	HealthCheckTree() HealthCheckTreeOutput
+
+	// HealthCheckTreeWithContext performs health checks on the scope tree with context support for cancellation and timeouts.
+	HealthCheckTreeWithContext(context.Context) HealthCheckTreeOutput
+
+	// HealthReport checks the services in the current scope and its ancestors, and returns a structured report.
+	HealthReport(HealthReportOpts) *HealthReport
+
+	// HealthReportWithContext checks the services with context support for cancellation and timeouts.
+	HealthReportWithContext(context.Context, HealthReportOpts) *HealthReport
+
 	// Shutdown gracefully shuts down the injector and all its descendant scopes.
 	Shutdown() *ShutdownReport
 
 	// ShutdownWithContext gracefully shuts down the injector and all its descendant scopes with context support.
 	ShutdownWithContext(context.Context) *ShutdownReport
 
+	// ShutdownPlan returns the order in which the injector and its descendant scopes would be shut down, without shutting them down.
+	ShutdownPlan() ShutdownPlanOutput
+
+	// Reset returns the services of the injector and its descendant scopes to their registered but unbuilt state.
+	Reset() *ShutdownReport
+
+	// ResetWithContext returns the services to their registered but unbuilt state with context support.
+	ResetWithContext(context.Context) *ShutdownReport
+
+	// Restart resets the injector and its descendant scopes, then builds again the lazy services that were built.
+	Restart() (*ShutdownReport, error)
+
+	// RestartWithContext resets the injector and builds the services again with context support.
+	RestartWithContext(context.Context) (*ShutdownReport, error)
+
 	// clone creates a deep copy of the injector with all its services and child scopes.
 	clone(*RootScope, *Scope) *Scope
 
@@ -79,6 +118,8 @@ type Injector interface {
 	serviceForEachRec(func(string, *Scope, any) bool)
 	// serviceHealthCheck performs a health check on a specific service in the current scope.
 	serviceHealthCheck(context.Context, string) error
+	// serviceCheck performs a health, readiness or liveness check on a specific service in the current scope.
+	serviceCheck(context.Context, string, HealthCheckKind) error
 	// serviceShutdown gracefully shuts down a specific service in the current scope.
 	serviceShutdown(context.Context, string) error
 	// onServiceInvoke is called whenever a service is invoked in this scope.
@@ -100,6 +141,29 @@ func getInjectorOrDefault(i Injector) Injector {
 	return DefaultRootScope
 }
 
+// Resolver resolves the instance of a service registered in a scope.
+// It is the unit of work wrapped by invocation middlewares (see InjectorOpts.InvocationMiddleware).
+//
+// Parameters:
+//   - scope: The scope where the service is registered
+//   - serviceName: The name of the service being resolved
+//
+// Returns the service instance and any error that occurred during resolution.
+type Resolver func(scope *Scope, serviceName string) (any, error)
+
+// FallbackResolver is called when a service is invoked by name but has not been registered.
+// It returns a registration function, such as the ones built by LazyNamed, EagerNamed or
+// TransientNamed, and the scope where the service must be registered. The target scope must
+// be the invoking scope or one of its ancestors, otherwise the invocation fails with
+// ErrFallbackTarget. When the target scope is nil, the service is registered in the invoking scope.
+//
+// Returning a nil registration function means the service cannot be resolved.
+//
+// Parameters:
+//   - scope: The scope where the service has been invoked
+//   - serviceName: The name of the missing service
+type FallbackResolver func(scope Injector, serviceName string) (target Injector, register func(Injector))
+
 // InjectorOpts contains all configuration options for the dependency injection container.
 // These options control logging, hooks, health checks, and other behavioral aspects
 // of the DI container.
@@ -128,11 +192,56 @@ type InjectorOpts struct {
 	// This hook can be used for logging, metrics collection, or error handling.
 	HookAfterShutdown []func(scope *Scope, serviceName string, err error)
 
+	// HookRegistrationEvent is called after a service is registered in a scope, with a detailed event.
+	// Hooks can also be added at runtime, and unregistered, with RootScope.AddRegistrationEventHook.
+	HookRegistrationEvent []func(event RegistrationEvent)
+
+	// HookInvocationEvent is called after a service is invoked, with a detailed event including
+	// the invoker chain, the invocation duration and whether the instance has been built.
+	// Hooks can also be added at runtime, and unregistered, with RootScope.AddInvocationEventHook.
+	HookInvocationEvent []func(event InvocationEvent)
+
+	// HookHealthCheckEvent is called after a service is health checked, with a detailed event.
+	// Hooks can also be added at runtime, and unregistered, with RootScope.AddHealthCheckEventHook.
+	HookHealthCheckEvent []func(event HealthCheckEvent)
+
+	// HookShutdownEvent is called after a service is shut down, with a detailed event.
+	// Hooks can also be added at runtime, and unregistered, with RootScope.AddShutdownEventHook.
+	HookShutdownEvent []func(event ShutdownEvent)
+
+	// InvocationMiddleware wraps the resolution of every invoked service, from the outermost
+	// middleware (first item) to the innermost one (last item).
+	// Unlike hooks, a middleware can replace the returned instance or error, or short-circuit
+	// the resolution by not calling next. This is useful for caching, access control, fault
+	// injection or tracing.
+	//
+	// The middlewares run between HookBeforeInvocation and HookAfterInvocation hooks.
+	// Calling next with the invoked scope and service name resolves the invoked service. Calling
+	// it with another scope or service name redirects the call: that service is invoked instead.
+	// When a middleware returns an instance of another type than the invoked one, the
+	// invocation fails with a type mismatch error.
+	// Middlewares can also be added at runtime, with RootScope.AddInvocationMiddleware.
+	InvocationMiddleware []func(next Resolver) Resolver
+
+	// FallbackResolver is called when a service is invoked by name but has not been registered.
+	// The service it returns is registered, so that later invocations hit the normal path.
+	// Default: no fallback, a missing service returns ErrServiceNotFound.
+	FallbackResolver FallbackResolver
+
 	// Logf is the logging function used by the DI container for internal logging.
 	// If not provided, no logging will occur. This function should handle the format
 	// string and arguments similar to fmt.Printf.
 	Logf func(format string, args ...any)
 
+	// Logger receives structured logs, with typed attributes such as scope, service, service type,
+	// duration and error, on every registration, invocation, health check and shutdown.
+	// *slog.Logger implements this interface. Logf keeps working when Logger is set.
+	Logger Logger
+
+	// Tracer opens a span for each provider build, health check and service shutdown.
+	// Build spans are nested along the invoker chain.
+	Tracer Tracer
+
 	// HealthCheckParallelism controls the number of concurrent health checks that can run simultaneously.
 	// Default: all health checks run in parallel (unlimited).
 	// Setting this to a positive number limits the concurrency for better resource management.
@@ -148,10 +257,38 @@ type InjectorOpts struct {
 	// Default: no timeout (individual health checks can run indefinitely).
 	HealthCheckTimeout time.Duration
 
+	// ShutdownTimeout sets a timeout for individual service shutdowns. It can be overridden per service
+	// with the WithShutdownTimeout registration option. A service overrunning its timeout is abandoned,
+	// and reported in ShutdownReport.TimedOut, so that the other services keep shutting down on time.
+	// Default: no timeout (individual shutdowns can run until the shutdown context is done).
+	ShutdownTimeout time.Duration
+
+	// ShutdownParallelism controls the number of services that can be drained or shut down simultaneously.
+	// Set it to 1 for a sequential shutdown: the services having no dependent are then shut down one at a
+	// time, sorted by scope name and service name, for deterministic logs.
+	// Default: all services having no dependent are shut down in parallel (unlimited).
+	ShutdownParallelism uint
+
+	// DrainPhaseTimeout and ShutdownPhaseTimeout set the budget of each phase of RootScope.ShutdownWithContext:
+	// draining the services implementing Drainer, then shutting down the services. Services still running
+	// when the budget expires are abandoned. The phases are reported in ShutdownReport.Phases.
+	// Default: no budget (phases run until the shutdown context is done).
+	DrainPhaseTimeout    time.Duration
+	ShutdownPhaseTimeout time.Duration
+
 	// StructTagKey specifies the tag key used for struct field injection.
 	// Default: "do" (see DefaultStructTagKey constant).
 	// This allows customization of the struct tag format for injection.
 	StructTagKey string
+
+	// Event hooks, initialized from HookXxxEvent fields when the injector is created.
+	registrationEventHooks *hookRegistry[RegistrationEvent]
+	invocationEventHooks   *hookRegistry[InvocationEvent]
+	healthCheckEventHooks  *hookRegistry[HealthCheckEvent]
+	shutdownEventHooks     *hookRegistry[ShutdownEvent]
+
+	// Invocation middlewares, initialized from InvocationMiddleware when the injector is created.
+	invocationMiddlewares *middlewareRegistry
 }
 
 func (o *InjectorOpts) copy() *InjectorOpts {
@@ -162,46 +299,110 @@ func (o *InjectorOpts) copy() *InjectorOpts {
 		HookAfterInvocation:      append([]func(*Scope, string, error){}, o.HookAfterInvocation...),
 		HookBeforeShutdown:       append([]func(*Scope, string){}, o.HookBeforeShutdown...),
 		HookAfterShutdown:        append([]func(*Scope, string, error){}, o.HookAfterShutdown...),
+		HookRegistrationEvent:    append([]func(RegistrationEvent){}, o.HookRegistrationEvent...),
+		HookInvocationEvent:      append([]func(InvocationEvent){}, o.HookInvocationEvent...),
+		HookHealthCheckEvent:     append([]func(HealthCheckEvent){}, o.HookHealthCheckEvent...),
+		HookShutdownEvent:        append([]func(ShutdownEvent){}, o.HookShutdownEvent...),
+		InvocationMiddleware:     append([]func(Resolver) Resolver{}, o.InvocationMiddleware...),
+		FallbackResolver:         o.FallbackResolver,
 		Logf:                     o.Logf,
+		Logger:                   o.Logger,
+		Tracer:                   o.Tracer,
 		HealthCheckParallelism:   o.HealthCheckParallelism,
 		HealthCheckGlobalTimeout: o.HealthCheckGlobalTimeout,
 		HealthCheckTimeout:       o.HealthCheckTimeout,
+		ShutdownTimeout:          o.ShutdownTimeout,
+		ShutdownParallelism:      o.ShutdownParallelism,
+		DrainPhaseTimeout:        o.DrainPhaseTimeout,
+		ShutdownPhaseTimeout:     o.ShutdownPhaseTimeout,
 		StructTagKey:             o.StructTagKey,
+
+		registrationEventHooks: o.registrationEventHooks.copy(),
+		invocationEventHooks:   o.invocationEventHooks.copy(),
+		healthCheckEventHooks:  o.healthCheckEventHooks.copy(),
+		shutdownEventHooks:     o.shutdownEventHooks.copy(),
+
+		invocationMiddlewares: o.invocationMiddlewares.copy(),
 	}
 }
 
+// runHook executes a hook, and recovers from a panic, so that a faulty hook cannot
+// crash the goroutine performing the registration, invocation or shutdown.
+func (o *InjectorOpts) runHook(kind string, serviceName string, hook func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			if o.Logf != nil {
+				o.Logf("DI: %s hook panicked for service %s: %v", kind, serviceName, r)
+			}
+			o.log(logLevelError, "DI: hook panicked", "hook", kind, LogKeyService, serviceName, "panic", r)
+		}
+	}()
+
+	hook()
+}
+
 func (o *InjectorOpts) onBeforeRegistration(scope *Scope, serviceName string) {
 	for _, fn := range o.HookBeforeRegistration {
-		fn(scope, serviceName)
+		o.runHook("before registration", serviceName, func() { fn(scope, serviceName) })
 	}
 }
 
 func (o *InjectorOpts) onAfterRegistration(scope *Scope, serviceName string) {
 	for _, fn := range o.HookAfterRegistration {
-		fn(scope, serviceName)
+		o.runHook("after registration", serviceName, func() { fn(scope, serviceName) })
 	}
 }
 
 func (o *InjectorOpts) onBeforeInvocation(scope *Scope, serviceName string) {
 	for _, fn := range o.HookBeforeInvocation {
-		fn(scope, serviceName)
+		o.runHook("before invocation", serviceName, func() { fn(scope, serviceName) })
 	}
 }
 
 func (o *InjectorOpts) onAfterInvocation(scope *Scope, serviceName string, err error) {
 	for _, fn := range o.HookAfterInvocation {
-		fn(scope, serviceName, err)
+		o.runHook("after invocation", serviceName, func() { fn(scope, serviceName, err) })
 	}
 }
 
 func (o *InjectorOpts) onBeforeShutdown(scope *Scope, serviceName string) {
 	for _, fn := range o.HookBeforeShutdown {
-		fn(scope, serviceName)
+		o.runHook("before shutdown", serviceName, func() { fn(scope, serviceName) })
 	}
 }
 
 func (o *InjectorOpts) onAfterShutdown(scope *Scope, serviceName string, err error) {
 	for _, fn := range o.HookAfterShutdown {
-		fn(scope, serviceName, err)
+		o.runHook("after shutdown", serviceName, func() { fn(scope, serviceName, err) })
+	}
+}
+
+func (o *InjectorOpts) onRegistrationEvent(scope *Scope, serviceName string, service any) {
+	hooks := o.registrationEventHooks.list()
+	if len(hooks) == 0 {
+		return
+	}
+
+	event := newRegistrationEvent(scope, serviceName, service)
+	for _, fn := range hooks {
+		o.runHook("registration event", serviceName, func() { fn(event) })
+	}
+}
+
+func (o *InjectorOpts) onInvocationEvent(event InvocationEvent) {
+	for _, fn := range o.invocationEventHooks.list() {
+		o.runHook("invocation event", event.ServiceName, func() { fn(event) })
+	}
+}
+
+func (o *InjectorOpts) onHealthCheckEvent(event HealthCheckEvent) {
+	for _, fn := range o.healthCheckEventHooks.list() {
+		o.runHook("health check event", event.ServiceName, func() { fn(event) })
+	}
+}
+
+func (o *InjectorOpts) onShutdownEvent(event ShutdownEvent) {
+	for _, fn := range o.shutdownEventHooks.list() {
+		o.runHook("shutdown event", event.ServiceName, func() { fn(event) })
 	}
 }
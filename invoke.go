@@ -1,11 +1,13 @@
 package do
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"reflect"
 	"sort"
 	"strings"
+	"time"
 	"unsafe"
 
 	typetostring "github.com/samber/go-type-to-string"
@@ -38,7 +40,10 @@ func invokeAnyByName(i Injector, name string) (any, error) {
 
 	invokerChain = append(invokerChain, name)
 
-	serviceAny, serviceScope, found := injector.serviceGetRec(name)
+	serviceAny, serviceScope, found, err := serviceGetRecOrFallback(injector, name)
+	if err != nil {
+		return nil, err
+	}
 	if !found {
 		return nil, serviceNotFound(injector, ErrServiceNotFound, invokerChain)
 	}
@@ -52,9 +57,9 @@ func invokeAnyByName(i Injector, name string) (any, error) {
 		return nil, serviceNotFound(injector, ErrServiceNotFound, invokerChain)
 	}
 
-	injector.RootScope().opts.onBeforeInvocation(serviceScope, name)
-	instance, err := service.getInstanceAny(newVirtualScope(serviceScope, invokerChain))
-	injector.RootScope().opts.onAfterInvocation(serviceScope, name, err)
+	instance, err := invokeInstance(serviceScope, name, name, service, invokerChain, func() (any, error) {
+		return service.getInstanceAny(newInvocationScope(injector, serviceScope, invokerChain))
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -94,7 +99,10 @@ func invokeByName[T any](i Injector, name string) (T, error) {
 
 	invokerChain = append(invokerChain, name)
 
-	serviceAny, serviceScope, found := injector.serviceGetRec(name)
+	serviceAny, serviceScope, found, err := serviceGetRecOrFallback(injector, name)
+	if err != nil {
+		return empty[T](), err
+	}
 	if !found {
 		return empty[T](), serviceNotFound(injector, ErrServiceNotFound, invokerChain)
 	}
@@ -108,9 +116,9 @@ func invokeByName[T any](i Injector, name string) (T, error) {
 		return empty[T](), serviceTypeMismatch(inferServiceName[T](), serviceAny.(serviceWrapperAny).getTypeName()) //nolint:errcheck,forcetypeassert
 	}
 
-	injector.RootScope().opts.onBeforeInvocation(serviceScope, name)
-	instance, err := service.getInstance(newVirtualScope(serviceScope, invokerChain))
-	injector.RootScope().opts.onAfterInvocation(serviceScope, name, err)
+	instance, err := invokeInstance(serviceScope, name, name, service, invokerChain, func() (T, error) {
+		return service.getInstance(newInvocationScope(injector, serviceScope, invokerChain))
+	})
 
 	if err != nil {
 		return empty[T](), err
@@ -174,11 +182,11 @@ func invokeByGenericType[T any](i Injector) (T, error) {
 		}
 	}
 
-	injector.RootScope().opts.onBeforeInvocation(serviceScope, serviceAliasName)
-	instance, err := serviceInstance.(serviceWrapperGetInstanceAny).getInstanceAny( //nolint:errcheck,forcetypeassert
-		newVirtualScope(serviceScope, append(invokerChain, serviceRealName)),
-	)
-	injector.RootScope().opts.onAfterInvocation(serviceScope, serviceAliasName, err)
+	instance, err := invokeInstance(serviceScope, serviceAliasName, serviceRealName, serviceInstance, append(invokerChain, serviceRealName), func() (any, error) {
+		return serviceInstance.(serviceWrapperGetInstanceAny).getInstanceAny( //nolint:errcheck,forcetypeassert
+			newInvocationScope(injector, serviceScope, append(invokerChain, serviceRealName)),
+		)
+	})
 
 	if err != nil {
 		return empty[T](), err
@@ -195,7 +203,210 @@ func invokeByGenericType[T any](i Injector) (T, error) {
 
 	injector.RootScope().opts.Logf("DI: service %s invoked", serviceAliasName)
 
-	return instance.(T), nil //nolint:errcheck,forcetypeassert
+	// a middleware may have replaced the instance by nil, or by a value of another type
+	t, ok := instance.(T)
+	if !ok {
+		registered := "nil"
+		if instance != nil {
+			registered = typetostring.GetReflectType(reflect.TypeOf(instance))
+		}
+		return empty[T](), serviceTypeMismatch(inferServiceName[T](), registered)
+	}
+
+	return t, nil
+}
+
+// serviceGetRecOrFallback retrieves a service by name from the current scope or any of
+// its ancestor scopes. When the service is missing, the fallback resolver declared in
+// InjectorOpts.FallbackResolver is asked to register it, and the lookup is retried.
+//
+// Parameters:
+//   - injector: The invoking scope
+//   - name: The name of the service to retrieve
+//
+// Returns the service instance, the scope where it was found, and true if found. An error is
+// returned when the fallback resolver targets a scope the invoking scope cannot reach.
+func serviceGetRecOrFallback(injector Injector, name string) (any, *Scope, bool, error) {
+	serviceAny, serviceScope, found := injector.serviceGetRec(name)
+	if found {
+		return serviceAny, serviceScope, found, nil
+	}
+
+	fallback := injector.RootScope().opts.FallbackResolver
+	if fallback == nil {
+		return nil, nil, false, nil
+	}
+
+	target, register := fallback(injector, name)
+	if register == nil {
+		return nil, nil, false, nil
+	}
+
+	if target == nil {
+		target = injector
+	}
+
+	if !isInjectorOrAncestor(injector, target) {
+		return nil, nil, false, fmt.Errorf("%w: service `%s` cannot be registered in scope `%s`", ErrFallbackTarget, name, target.Name())
+	}
+
+	registerFallbackService(target, name, register)
+
+	serviceAny, serviceScope, found = injector.serviceGetRec(name)
+	if found {
+		injector.RootScope().opts.logScope(logLevelInfo, "DI: service registered by fallback resolver", serviceScope, LogKeyService, name)
+	}
+
+	return serviceAny, serviceScope, found, nil
+}
+
+// isInjectorOrAncestor returns true when target is the injector or one of its ancestors.
+func isInjectorOrAncestor(injector Injector, target Injector) bool {
+	if target.ID() == injector.ID() {
+		return true
+	}
+
+	for _, ancestor := range injector.Ancestors() {
+		if ancestor.ID() == target.ID() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// registerFallbackService applies the registration function returned by the fallback resolver.
+// Since concurrent invocations may resolve the same missing service, a double registration
+// is ignored when the service has been registered in the meantime.
+func registerFallbackService(target Injector, name string, register func(Injector)) {
+	defer func() {
+		if r := recover(); r != nil && !target.serviceExist(name) {
+			panic(r)
+		}
+	}()
+
+	if target.serviceExist(name) {
+		return
+	}
+
+	register(target)
+}
+
+// invokeInstance instantiates a service between the before and after invocation hooks,
+// and sends an InvocationEvent to the invocation event hooks.
+//
+// Parameters:
+//   - serviceScope: The scope where the service is registered
+//   - hookName: The name passed to the legacy invocation hooks (the alias name for InvokeAs)
+//   - name: The name of the service being invoked
+//   - service: The service wrapper
+//   - invokerChain: The chain of invoked services, ending with this service
+//   - getInstance: The callback instantiating the service
+//
+// Returns the service instance and any error that occurred during invocation.
+func invokeInstance[T any](serviceScope *Scope, hookName string, name string, service any, invokerChain []string, getInstance func() (T, error)) (T, error) {
+	opts := serviceScope.RootScope().opts
+
+	opts.onBeforeInvocation(serviceScope, hookName)
+
+	if opts.invocationEventHooks.isEmpty() && opts.Logger == nil {
+		instance, err := resolveInstance(serviceScope, name, getInstance)
+		if err == nil {
+			serviceScope.RootScope().superviseService(serviceScope, name, service, instance)
+		}
+		opts.onAfterInvocation(serviceScope, hookName, err)
+		return instance, err
+	}
+
+	hadInstance := serviceHasInstance(service)
+	start := time.Now()
+	instance, err := resolveInstance(serviceScope, name, getInstance)
+	duration := time.Since(start)
+	if err == nil {
+		serviceScope.RootScope().superviseService(serviceScope, name, service, instance)
+	}
+
+	opts.onAfterInvocation(serviceScope, hookName, err)
+
+	built := err == nil && !hadInstance && serviceHasInstance(service)
+	if svc, ok := service.(serviceWrapperGetServiceType); ok && svc.getServiceType() == ServiceTypeTransient {
+		built = err == nil
+	}
+
+	opts.logService(logLevelError, "DI: service invoked", serviceScope, name, service, duration, err, LogKeyBuilt, built)
+
+	if opts.invocationEventHooks.isEmpty() {
+		return instance, err
+	}
+
+	event := InvocationEvent{
+		Scope:        serviceScope,
+		ServiceName:  name,
+		InvokerChain: append([]string{}, invokerChain...),
+		Built:        built,
+		Duration:     duration,
+		Error:        err,
+	}
+
+	if svc, ok := service.(serviceWrapperAny); ok {
+		event.ServiceType = svc.getServiceType()
+		event.ReflectType = svc.getReflectType()
+		event.ProviderFrame, event.InvocationFrames = svc.source()
+	}
+
+	opts.onInvocationEvent(event)
+
+	return instance, err
+}
+
+// resolveInstance instantiates a service, through the invocation middlewares declared
+// in InjectorOpts.InvocationMiddleware. When no middleware has been registered, the
+// callback is called directly, to keep the default invocation path cheap.
+//
+// Parameters:
+//   - serviceScope: The scope where the service is registered
+//   - name: The name of the service being invoked
+//   - getInstance: The callback instantiating the service
+//
+// Returns the service instance and any error that occurred during invocation.
+// An error is returned if a middleware replaced the instance by a value of another type.
+func resolveInstance[T any](serviceScope *Scope, name string, getInstance func() (T, error)) (T, error) {
+	middlewares := serviceScope.RootScope().opts.invocationMiddlewares.list()
+	if len(middlewares) == 0 {
+		return getInstance()
+	}
+
+	var resolve Resolver = func(scope *Scope, serviceName string) (any, error) {
+		if scope == nil {
+			scope = serviceScope
+		}
+
+		if scope == serviceScope && serviceName == name {
+			return getInstance()
+		}
+
+		// a middleware redirected the call to another service or scope
+		return invokeAnyByName(scope, serviceName)
+	}
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		resolve = middlewares[i](resolve)
+	}
+
+	instance, err := resolve(serviceScope, name)
+	if err != nil {
+		return empty[T](), err
+	}
+
+	if instance == nil {
+		return empty[T](), nil
+	}
+
+	t, ok := instance.(T)
+	if !ok {
+		return empty[T](), serviceTypeMismatch(inferServiceName[T](), typetostring.GetReflectType(reflect.TypeOf(instance)))
+	}
+
+	return t, nil
 }
 
 // invokeByTag injects services into struct fields based on struct tags.
@@ -362,7 +573,13 @@ func humanReadableInvokerChain(invokerChain []string) string {
 	return strings.Join(invokerChain, " -> ")
 }
 
+// handleProviderPanic executes the provider and runs the post-construct hooks
+// (see Initializer and Validator) on the returned instance. A panic in the
+// provider or in a hook is recovered and returned as an error.
 func handleProviderPanic[T any](provider Provider[T], i Injector) (svc T, err error) {
+	i, ctx, span := startBuildSpan(i)
+	defer func() { span.End(err) }()
+
 	defer func() {
 		if r := recover(); r != nil {
 			if e, ok := r.(error); ok {
@@ -374,6 +591,9 @@ func handleProviderPanic[T any](provider Provider[T], i Injector) (svc T, err er
 	}()
 
 	_svc, _err := provider(i)
+	if _err == nil {
+		_err = initializeService(ctx, _svc)
+	}
 
 	// do not return svc when err != nil
 	if _err != nil {
@@ -384,3 +604,35 @@ func handleProviderPanic[T any](provider Provider[T], i Injector) (svc T, err er
 
 	return svc, err
 }
+
+// initializeService runs the post-construct hooks of a freshly built instance:
+// Init first, so that the instance can complete its wiring, then Validate.
+// When a hook fails or panics, the instance is dropped: it is shut down, so that
+// the resources opened by Init are released.
+func initializeService(ctx context.Context, instance any) (err error) {
+	initialized := false
+	defer func() {
+		if initialized {
+			return
+		}
+
+		if shutdownErr := instanceShutdown(ctx, instance); shutdownErr != nil && err != nil {
+			err = fmt.Errorf("%w (shutdown: %s)", err, shutdownErr.Error())
+		}
+	}()
+
+	if initializer, ok := instance.(Initializer); ok {
+		if err := initializer.Init(ctx); err != nil {
+			return err
+		}
+	}
+
+	if validator, ok := instance.(Validator); ok {
+		if err := validator.Validate(); err != nil {
+			return err
+		}
+	}
+
+	initialized = true
+	return nil
+}
@@ -1,6 +1,7 @@
 package do
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"sync"
@@ -468,6 +469,383 @@ func TestInvokeByGenericType_race(t *testing.T) {
 	wg.Wait()
 }
 
+func TestServiceGetRecOrFallback(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	// no fallback
+	i := New()
+	_, _, found, err := serviceGetRecOrFallback(i, "foobar")
+	is.NoError(err)
+	is.False(found)
+
+	// fallback unable to resolve the service
+	calls := 0
+	i = NewWithOpts(&InjectorOpts{
+		FallbackResolver: func(scope Injector, serviceName string) (Injector, func(Injector)) {
+			calls++
+			return nil, nil
+		},
+	})
+	_, _, found, err = serviceGetRecOrFallback(i, "foobar")
+	is.NoError(err)
+	is.False(found)
+	is.Equal(1, calls)
+
+	// fallback registering the service in the invoking scope
+	i = NewWithOpts(&InjectorOpts{
+		FallbackResolver: func(scope Injector, serviceName string) (Injector, func(Injector)) {
+			calls++
+			return nil, EagerNamed(serviceName, 42)
+		},
+	})
+	child := i.Scope("child")
+	calls = 0
+	svc, svcScope, found, err := serviceGetRecOrFallback(child, "foobar")
+	is.NoError(err)
+	is.True(found)
+	is.Equal(child, svcScope)
+	is.Equal(ServiceTypeEager, svc.(serviceWrapperAny).getServiceType())
+	is.Equal(1, calls)
+
+	// second lookup hits the registered service
+	_, _, found, err = serviceGetRecOrFallback(child, "foobar")
+	is.NoError(err)
+	is.True(found)
+	is.Equal(1, calls)
+
+	// fallback registering the service in the root scope
+	i = NewWithOpts(&InjectorOpts{
+		FallbackResolver: func(scope Injector, serviceName string) (Injector, func(Injector)) {
+			return scope.RootScope(), EagerNamed(serviceName, 42)
+		},
+	})
+	child = i.Scope("child")
+	_, svcScope, found, err = serviceGetRecOrFallback(child, "foobar")
+	is.NoError(err)
+	is.True(found)
+	is.Equal(i.self, svcScope)
+
+	// fallback registering the service in an unreachable scope
+	var unreachable *Scope
+	i = NewWithOpts(&InjectorOpts{
+		FallbackResolver: func(scope Injector, serviceName string) (Injector, func(Injector)) {
+			return unreachable, EagerNamed(serviceName, 42)
+		},
+	})
+	unreachable = i.Scope("unreachable")
+	_, _, found, err = serviceGetRecOrFallback(i, "foobar")
+	is.ErrorIs(err, ErrFallbackTarget)
+	is.False(found)
+	is.False(unreachable.serviceExist("foobar"))
+
+	_, err = InvokeNamed[int](i, "foobar")
+	is.ErrorIs(err, ErrFallbackTarget)
+}
+
+func TestRegisterFallbackService(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	i := New()
+
+	registerFallbackService(i, "foobar", EagerNamed("foobar", 42))
+	is.True(i.serviceExist("foobar"))
+
+	// already registered, concurrently
+	is.NotPanics(func() {
+		registerFallbackService(i, "foobar", EagerNamed("foobar", 1337))
+	})
+	is.Equal(42, MustInvokeNamed[int](i, "foobar"))
+
+	// registration panics for another reason
+	is.Panics(func() {
+		registerFallbackService(i, "hello", func(Injector) { panic("aïe") })
+	})
+}
+
+func TestFallbackResolver(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	type dependency struct{}
+	type test struct {
+		Dependency *dependency `do:""`
+	}
+
+	i := NewWithOpts(&InjectorOpts{
+		FallbackResolver: func(scope Injector, serviceName string) (Injector, func(Injector)) {
+			if serviceName == NameOf[*test]() {
+				return scope.RootScope(), LazyNamed(serviceName, func(i Injector) (*test, error) {
+					return InvokeStruct[*test](i)
+				})
+			}
+			return nil, nil
+		},
+	})
+	ProvideValue(i, &dependency{})
+
+	// invokeByName
+	svc1, err := Invoke[*test](i)
+	is.NoError(err)
+	is.NotNil(svc1.Dependency)
+
+	svc2, err := Invoke[*test](i)
+	is.NoError(err)
+	is.Same(svc1, svc2)
+
+	// dependencies of the resolved service are recorded in the DAG
+	dependencies, _ := i.dag.explainService(i.ID(), i.Name(), NameOf[*test]())
+	is.Equal([]ServiceDescription{newServiceDescription(i.ID(), i.Name(), NameOf[*dependency]())}, dependencies)
+
+	// invokeAnyByName
+	svc3, err := InvokeNamed[any](i, NameOf[*test]())
+	is.NoError(err)
+	is.Same(svc1, svc3)
+
+	// unresolved service
+	_, err = Invoke[int](i)
+	is.ErrorIs(err, ErrServiceNotFound)
+}
+
+func TestResolveInstance(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	calls := []string{}
+	tracing := func(label string) func(next Resolver) Resolver {
+		return func(next Resolver) Resolver {
+			return func(scope *Scope, serviceName string) (any, error) {
+				calls = append(calls, label+":before:"+serviceName)
+				instance, err := next(scope, serviceName)
+				calls = append(calls, label+":after:"+serviceName)
+				return instance, err
+			}
+		}
+	}
+
+	// no middleware
+	i := New()
+	instance, err := resolveInstance(i.self, "foobar", func() (int, error) { return 42, nil })
+	is.NoError(err)
+	is.Equal(42, instance)
+
+	// middlewares are called in declaration order
+	i = NewWithOpts(&InjectorOpts{
+		InvocationMiddleware: []func(Resolver) Resolver{tracing("a"), tracing("b")},
+	})
+	instance, err = resolveInstance(i.self, "foobar", func() (int, error) {
+		calls = append(calls, "resolve")
+		return 42, nil
+	})
+	is.NoError(err)
+	is.Equal(42, instance)
+	is.Equal([]string{"a:before:foobar", "b:before:foobar", "resolve", "b:after:foobar", "a:after:foobar"}, calls)
+
+	// error
+	_, err = resolveInstance(i.self, "foobar", func() (int, error) { return 0, assert.AnError })
+	is.ErrorIs(err, assert.AnError)
+
+	// short-circuit
+	i = NewWithOpts(&InjectorOpts{
+		InvocationMiddleware: []func(Resolver) Resolver{
+			func(next Resolver) Resolver {
+				return func(scope *Scope, serviceName string) (any, error) {
+					return 1337, nil
+				}
+			},
+		},
+	})
+	instance, err = resolveInstance(i.self, "foobar", func() (int, error) {
+		is.Fail("should not be called")
+		return 42, nil
+	})
+	is.NoError(err)
+	is.Equal(1337, instance)
+
+	// type mismatch
+	_, err = resolveInstance(i.self, "foobar", func() (string, error) { return "42", nil })
+	is.EqualError(err, "DI: service found, but type mismatch: invoking `string` but registered `int`")
+
+	// nil instance
+	ptr, err := resolveInstance(New().self, "foobar", func() (*int, error) { return nil, nil })
+	is.NoError(err)
+	is.Nil(ptr)
+}
+
+func TestInvocationMiddleware(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	type test struct {
+		foobar string
+	}
+
+	hooks := []string{}
+	i := NewWithOpts(&InjectorOpts{
+		HookBeforeInvocation: []func(*Scope, string){
+			func(scope *Scope, serviceName string) { hooks = append(hooks, "before:"+serviceName) },
+		},
+		HookAfterInvocation: []func(*Scope, string, error){
+			func(scope *Scope, serviceName string, err error) { hooks = append(hooks, "after:"+serviceName) },
+		},
+	})
+	i.AddInvocationMiddleware(func(next Resolver) Resolver {
+		return func(scope *Scope, serviceName string) (any, error) {
+			hooks = append(hooks, "middleware:"+serviceName)
+			if serviceName == "forbidden" {
+				return nil, assert.AnError
+			}
+			return next(scope, serviceName)
+		}
+	})
+
+	Provide(i, func(i Injector) (*test, error) {
+		return &test{foobar: "foobar"}, nil
+	})
+	ProvideNamedValue(i, "forbidden", 42)
+
+	// invokeByName
+	svc, err := Invoke[*test](i)
+	is.NoError(err)
+	is.Equal("foobar", svc.foobar)
+	is.Equal([]string{"before:" + NameOf[*test](), "middleware:" + NameOf[*test](), "after:" + NameOf[*test]()}, hooks)
+
+	// invokeAnyByName
+	hooks = []string{}
+	_, err = InvokeNamed[any](i, "forbidden")
+	is.ErrorIs(err, assert.AnError)
+	is.Equal([]string{"before:forbidden", "middleware:forbidden", "after:forbidden"}, hooks)
+	is.NotContains(i.ListInvokedServices(), newServiceDescription(i.ID(), i.Name(), "forbidden"))
+
+	// invokeByGenericType
+	hooks = []string{}
+	_, err = InvokeAs[*test](i)
+	is.NoError(err)
+	is.Contains(hooks, "middleware:"+NameOf[*test]())
+}
+
+func TestInvocationMiddleware_typeMismatch(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	var replacement any
+	i := New()
+	i.AddInvocationMiddleware(func(next Resolver) Resolver {
+		return func(scope *Scope, serviceName string) (any, error) {
+			return replacement, nil
+		}
+	})
+	Provide(i, func(i Injector) (*lazyTestHeathcheckerOK, error) {
+		return &lazyTestHeathcheckerOK{foobar: "foobar"}, nil
+	})
+
+	// nil instance
+	is.NotPanics(func() {
+		svc, err := InvokeAs[Healthchecker](i)
+		is.EqualError(err, "DI: service found, but type mismatch: invoking `github.com/samber/do/v2.Healthchecker` but registered `nil`")
+		is.Nil(svc)
+	})
+
+	// instance of another type
+	replacement = 42
+	is.NotPanics(func() {
+		svc, err := InvokeAs[Healthchecker](i)
+		is.EqualError(err, "DI: service found, but type mismatch: invoking `github.com/samber/do/v2.Healthchecker` but registered `int`")
+		is.Nil(svc)
+
+		_, err = Invoke[*lazyTestHeathcheckerOK](i)
+		is.EqualError(err, "DI: service found, but type mismatch: invoking `*github.com/samber/do/v2.lazyTestHeathcheckerOK` but registered `int`")
+	})
+}
+
+func TestInvocationMiddleware_redirect(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	hooks := []string{}
+	i := NewWithOpts(&InjectorOpts{
+		HookBeforeInvocation: []func(*Scope, string){
+			func(scope *Scope, serviceName string) { hooks = append(hooks, scope.Name()+":"+serviceName) },
+		},
+	})
+	child := i.Scope("child")
+
+	ProvideNamedValue(i, "db", "primary")
+	ProvideNamedValue(i, "db-replica", "replica")
+	ProvideNamedValue(child, "db", "child")
+
+	i.AddInvocationMiddleware(func(next Resolver) Resolver {
+		return func(scope *Scope, serviceName string) (any, error) {
+			switch {
+			case serviceName == "db" && scope == i.self:
+				// redirect to another service
+				return next(scope, "db-replica")
+			case serviceName == "db" && scope == child:
+				// redirect to another scope
+				return next(i.self, serviceName)
+			}
+			return next(scope, serviceName)
+		}
+	})
+
+	is.Equal("replica", MustInvokeNamed[string](i, "db"))
+	is.Equal([]string{"[root]:db", "[root]:db-replica"}, hooks)
+
+	// the redirected call goes through the middlewares again
+	hooks = []string{}
+	is.Equal("replica", MustInvokeNamed[string](child, "db"))
+	is.Equal([]string{"child:db", "[root]:db", "[root]:db-replica"}, hooks)
+
+	// unknown service
+	i.AddInvocationMiddleware(func(next Resolver) Resolver {
+		return func(scope *Scope, serviceName string) (any, error) {
+			return next(scope, "not-found")
+		}
+	})
+	_, err := InvokeNamed[string](i, "db-replica")
+	is.ErrorIs(err, ErrServiceNotFound)
+}
+
+func TestInvocationMiddleware_concurrentAdd(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	i := New()
+	ProvideNamedTransient(i, "foobar", func(i Injector) (int, error) {
+		return 42, nil
+	})
+
+	var wg sync.WaitGroup
+	for n := 0; n < 10; n++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			i.AddInvocationMiddleware(func(next Resolver) Resolver {
+				return func(scope *Scope, serviceName string) (any, error) { return next(scope, serviceName) }
+			})
+		}()
+		go func() {
+			defer wg.Done()
+			_, err := InvokeNamed[int](i, "foobar")
+			is.NoError(err)
+		}()
+	}
+	wg.Wait()
+
+	value, err := InvokeNamed[int](i, "foobar")
+	is.NoError(err)
+	is.Equal(42, value)
+}
+
 func TestInvokeByTags(t *testing.T) {
 	t.Parallel()
 	testWithTimeout(t, 100*time.Millisecond)
@@ -788,5 +1166,78 @@ func TestHandleProviderPanic(t *testing.T) {
 		}, nil)
 		is.Equal(assert.AnError, err)
 		is.Equal(0, svc)
+
+		// post-construct hooks are executed
+		svc2, err := handleProviderPanic(func(i Injector) (*lifecycleTestInitializer, error) {
+			return &lifecycleTestInitializer{}, nil
+		}, nil)
+		is.NoError(err)
+		is.True(svc2.initialized)
+		is.True(svc2.validated)
+
+		// post-construct hook returns an error
+		svc2, err = handleProviderPanic(func(i Injector) (*lifecycleTestInitializer, error) {
+			return &lifecycleTestInitializer{initErr: assert.AnError}, nil
+		}, nil)
+		is.Equal(assert.AnError, err)
+		is.Nil(svc2)
+
+		// post-construct hook panics
+		svc2, err = handleProviderPanic(func(i Injector) (*lifecycleTestInitializer, error) {
+			return &lifecycleTestInitializer{validatePanic: true}, nil
+		}, nil)
+		is.EqualError(err, "DI: aïe")
+		is.Nil(svc2)
 	})
 }
+
+func TestInitializeService(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	// not an initializer
+	is.NoError(initializeService(context.Background(), 42))
+	is.NoError(initializeService(context.Background(), nil))
+
+	// init then validate
+	svc := &lifecycleTestInitializer{}
+	is.NoError(initializeService(context.Background(), svc))
+	is.True(svc.initialized)
+	is.True(svc.validated)
+
+	// init fails: validation is skipped
+	svc = &lifecycleTestInitializer{initErr: assert.AnError}
+	is.ErrorIs(initializeService(context.Background(), svc), assert.AnError)
+	is.True(svc.initialized)
+	is.False(svc.validated)
+
+	// validation fails
+	svc = &lifecycleTestInitializer{validateErr: assert.AnError}
+	is.ErrorIs(initializeService(context.Background(), svc), assert.AnError)
+	is.True(svc.initialized)
+	is.True(svc.validated)
+
+	// validator only
+	validator := &lifecycleTestValidator{err: assert.AnError}
+	is.ErrorIs(initializeService(context.Background(), validator), assert.AnError)
+
+	// the instance is shut down when a hook fails
+	shutdowner := &lifecycleTestShutdowner{lifecycleTestInitializer: lifecycleTestInitializer{initErr: assert.AnError}}
+	is.ErrorIs(initializeService(context.Background(), shutdowner), assert.AnError)
+	is.Equal(1, shutdowner.shutdowns)
+
+	shutdowner = &lifecycleTestShutdowner{lifecycleTestInitializer: lifecycleTestInitializer{validateErr: assert.AnError}, shutdownErr: assert.AnError}
+	err := initializeService(context.Background(), shutdowner)
+	is.ErrorIs(err, assert.AnError)
+	is.EqualError(err, assert.AnError.Error()+" (shutdown: "+assert.AnError.Error()+")")
+	is.Equal(1, shutdowner.shutdowns)
+
+	shutdowner = &lifecycleTestShutdowner{lifecycleTestInitializer: lifecycleTestInitializer{validatePanic: true}}
+	is.Panics(func() { _ = initializeService(context.Background(), shutdowner) })
+	is.Equal(1, shutdowner.shutdowns)
+
+	shutdowner = &lifecycleTestShutdowner{}
+	is.NoError(initializeService(context.Background(), shutdowner))
+	is.Equal(0, shutdowner.shutdowns)
+}
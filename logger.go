@@ -0,0 +1,100 @@
+package do
+
+import (
+	"time"
+)
+
+// Logger is a structured logger, receiving a message and a list of key-value pairs.
+// It is implemented by *slog.Logger, so a slog logger can be passed to InjectorOpts.Logger
+// without any adapter:
+//
+//	injector := do.NewWithOpts(&do.InjectorOpts{
+//	    Logger: slog.Default(),
+//	})
+type Logger interface {
+	Debug(msg string, args ...any)
+	Info(msg string, args ...any)
+	Warn(msg string, args ...any)
+	Error(msg string, args ...any)
+}
+
+// Keys of the attributes sent to the structured logger.
+const (
+	LogKeyScopeID     = "scope_id"
+	LogKeyScopeName   = "scope_name"
+	LogKeyService     = "service"
+	LogKeyServiceType = "service_type"
+	LogKeyDuration    = "duration"
+	LogKeyBuilt       = "built"
+	LogKeyError       = "error"
+	LogKeyRestart     = "restart"
+)
+
+type logLevel int
+
+const (
+	logLevelDebug logLevel = iota
+	logLevelInfo
+	logLevelWarn
+	logLevelError
+)
+
+// log sends a message to the structured logger, if any.
+func (o *InjectorOpts) log(level logLevel, msg string, args ...any) {
+	if o.Logger == nil {
+		return
+	}
+
+	switch level {
+	case logLevelDebug:
+		o.Logger.Debug(msg, args...)
+	case logLevelInfo:
+		o.Logger.Info(msg, args...)
+	case logLevelWarn:
+		o.Logger.Warn(msg, args...)
+	case logLevelError:
+		o.Logger.Error(msg, args...)
+	}
+}
+
+// logScope sends a message about a scope to the structured logger, if any.
+func (o *InjectorOpts) logScope(level logLevel, msg string, scope *Scope, args ...any) {
+	if o.Logger == nil {
+		return
+	}
+
+	o.log(level, msg, append(scopeLogAttrs(scope), args...)...)
+}
+
+// logService sends a message about a service to the structured logger, if any.
+// When err is not nil, the message is logged with errLevel instead of the debug level.
+func (o *InjectorOpts) logService(errLevel logLevel, msg string, scope *Scope, serviceName string, service any, duration time.Duration, err error, args ...any) {
+	if o.Logger == nil {
+		return
+	}
+
+	attrs := append(scopeLogAttrs(scope), LogKeyService, serviceName)
+	if svc, ok := service.(serviceWrapperGetServiceType); ok {
+		attrs = append(attrs, LogKeyServiceType, string(svc.getServiceType()))
+	}
+	if duration > 0 {
+		attrs = append(attrs, LogKeyDuration, duration)
+	}
+	attrs = append(attrs, args...)
+
+	level := logLevelDebug
+	if err != nil {
+		level = errLevel
+		attrs = append(attrs, LogKeyError, err)
+	}
+
+	o.log(level, msg, attrs...)
+}
+
+func scopeLogAttrs(scope *Scope) []any {
+	if scope == nil {
+		return []any{}
+	}
+
+	return []any{LogKeyScopeID, scope.id, LogKeyScopeName, scope.name}
+}
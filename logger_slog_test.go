@@ -0,0 +1,42 @@
+//go:build go1.21
+
+package do
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var _ Logger = (*slog.Logger)(nil)
+
+func TestLogger_slog(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	i := NewWithOpts(&InjectorOpts{Logger: logger})
+	ProvideNamedValue(i, "foobar", 42)
+	_, _ = InvokeNamed[int](i, "foobar")
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	is.Len(lines, 3)
+
+	var record map[string]any
+	is.NoError(json.Unmarshal([]byte(lines[2]), &record))
+	is.Equal("DEBUG", record["level"])
+	is.Equal("DI: service invoked", record["msg"])
+	is.Equal(i.ID(), record[LogKeyScopeID])
+	is.Equal("[root]", record[LogKeyScopeName])
+	is.Equal("foobar", record[LogKeyService])
+	is.Equal("eager", record[LogKeyServiceType])
+	is.Equal(false, record[LogKeyBuilt])
+}
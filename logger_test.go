@@ -0,0 +1,190 @@
+package do
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testLoggerRecord struct {
+	level string
+	msg   string
+	attrs map[string]any
+}
+
+type testLogger struct {
+	mu      sync.Mutex
+	records []testLoggerRecord
+}
+
+func (l *testLogger) record(level string, msg string, args ...any) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	attrs := map[string]any{}
+	for i := 0; i+1 < len(args); i += 2 {
+		attrs[args[i].(string)] = args[i+1] //nolint:errcheck,forcetypeassert
+	}
+
+	l.records = append(l.records, testLoggerRecord{level: level, msg: msg, attrs: attrs})
+}
+
+func (l *testLogger) Debug(msg string, args ...any) { l.record("debug", msg, args...) }
+func (l *testLogger) Info(msg string, args ...any)  { l.record("info", msg, args...) }
+func (l *testLogger) Warn(msg string, args ...any)  { l.record("warn", msg, args...) }
+func (l *testLogger) Error(msg string, args ...any) { l.record("error", msg, args...) }
+
+func (l *testLogger) find(msg string, service string) []testLoggerRecord {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return filter(l.records, func(r testLoggerRecord, _ int) bool {
+		return r.msg == msg && (service == "" || r.attrs[LogKeyService] == service)
+	})
+}
+
+func TestInjectorOpts_log(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	// no logger
+	opts := &InjectorOpts{}
+	is.NotPanics(func() {
+		opts.log(logLevelError, "foobar")
+		opts.logScope(logLevelError, "foobar", nil)
+		opts.logService(logLevelError, "foobar", nil, "svc", nil, 0, assert.AnError)
+	})
+
+	logger := &testLogger{}
+	opts = &InjectorOpts{Logger: logger}
+
+	opts.log(logLevelDebug, "a")
+	opts.log(logLevelInfo, "b")
+	opts.log(logLevelWarn, "c")
+	opts.log(logLevelError, "d")
+	is.Equal([]string{"debug", "info", "warn", "error"}, mAp(logger.records, func(r testLoggerRecord, _ int) string { return r.level }))
+
+	scope := &Scope{id: "id", name: "name"}
+	opts.logService(logLevelWarn, "ok", scope, "svc", &serviceLazy[int]{}, time.Second, nil, "foo", "bar")
+	records := logger.find("ok", "svc")
+	is.Len(records, 1)
+	is.Equal("debug", records[0].level)
+	is.Equal(map[string]any{
+		LogKeyScopeID:     "id",
+		LogKeyScopeName:   "name",
+		LogKeyService:     "svc",
+		LogKeyServiceType: "lazy",
+		LogKeyDuration:    time.Second,
+		"foo":             "bar",
+	}, records[0].attrs)
+
+	opts.logService(logLevelWarn, "ko", scope, "svc", nil, 0, assert.AnError)
+	records = logger.find("ko", "svc")
+	is.Len(records, 1)
+	is.Equal("warn", records[0].level)
+	is.Equal(map[string]any{
+		LogKeyScopeID:   "id",
+		LogKeyScopeName: "name",
+		LogKeyService:   "svc",
+		LogKeyError:     assert.AnError,
+	}, records[0].attrs)
+}
+
+func TestLogger(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	logger := &testLogger{}
+	var mu sync.Mutex
+	logs := []string{}
+
+	i := NewWithOpts(&InjectorOpts{
+		Logger: logger,
+		Logf: func(format string, args ...any) {
+			mu.Lock()
+			defer mu.Unlock()
+			logs = append(logs, format)
+		},
+	})
+	is.Len(logger.find("DI: injector created", ""), 1)
+
+	scope := i.Scope("child")
+	Provide(scope, func(i Injector) (*lazyTestHeathcheckerKO, error) { return &lazyTestHeathcheckerKO{}, nil })
+	ProvideNamed(scope, "ko", func(i Injector) (int, error) { return 0, assert.AnError })
+	ProvideNamedValue(i, "shutdown", &lazyTestShutdownerKO{})
+
+	// registration
+	records := logger.find("DI: service registered", NameOf[*lazyTestHeathcheckerKO]())
+	is.Len(records, 1)
+	is.Equal(scope.ID(), records[0].attrs[LogKeyScopeID])
+	is.Equal("child", records[0].attrs[LogKeyScopeName])
+	is.Equal("lazy", records[0].attrs[LogKeyServiceType])
+
+	// invocation
+	_, _ = Invoke[*lazyTestHeathcheckerKO](scope)
+	_, _ = Invoke[*lazyTestHeathcheckerKO](scope)
+	records = logger.find("DI: service invoked", NameOf[*lazyTestHeathcheckerKO]())
+	is.Len(records, 2)
+	is.Equal("debug", records[0].level)
+	is.Equal(true, records[0].attrs[LogKeyBuilt])
+	is.Equal(false, records[1].attrs[LogKeyBuilt])
+	is.Contains(records[0].attrs, LogKeyDuration)
+
+	_, err := InvokeNamed[int](scope, "ko")
+	is.Error(err)
+	records = logger.find("DI: service invoked", "ko")
+	is.Len(records, 1)
+	is.Equal("error", records[0].level)
+	is.ErrorIs(records[0].attrs[LogKeyError].(error), assert.AnError) //nolint:errcheck,forcetypeassert
+
+	// health check
+	_ = scope.HealthCheck()
+	records = logger.find("DI: service health checked", NameOf[*lazyTestHeathcheckerKO]())
+	is.Len(records, 1)
+	is.Equal("warn", records[0].level)
+	is.Error(records[0].attrs[LogKeyError].(error)) //nolint:errcheck,forcetypeassert
+
+	// shutdown
+	err = ShutdownNamed(i, "shutdown")
+	is.Error(err)
+	records = logger.find("DI: service shut down", "shutdown")
+	is.Len(records, 1)
+	is.Equal("error", records[0].level)
+	is.Equal("eager", records[0].attrs[LogKeyServiceType])
+
+	_ = i.Shutdown()
+	is.NotEmpty(logger.find("DI: scope shut down", ""))
+
+	// Logf keeps working
+	mu.Lock()
+	defer mu.Unlock()
+	is.Contains(logs, "DI: injector created")
+	is.Contains(logs, "DI: service %s invoked")
+}
+
+func TestLogger_hookPanic(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	logger := &testLogger{}
+
+	i := NewWithOpts(&InjectorOpts{
+		Logger: logger,
+		HookAfterRegistration: []func(*Scope, string){
+			func(*Scope, string) { panic(errors.New("boom")) },
+		},
+	})
+
+	ProvideNamedValue(i, "foobar", 42)
+
+	records := logger.find("DI: hook panicked", "foobar")
+	is.Len(records, 1)
+	is.Equal("error", records[0].level)
+	is.Equal("after registration", records[0].attrs["hook"])
+}
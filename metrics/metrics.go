@@ -0,0 +1,339 @@
+package dometrics
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/samber/do/v2"
+)
+
+// ContentType is the content type of the Prometheus text exposition format.
+const ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+type serviceKey struct {
+	scopeID     string
+	scopeName   string
+	serviceName string
+}
+
+type checkKey struct {
+	serviceKey
+	kind do.HealthCheckKind
+}
+
+type checkMetrics struct {
+	count    uint64
+	errors   uint64
+	duration time.Duration
+	healthy  bool
+}
+
+type serviceMetrics struct {
+	invocations      uint64
+	invocationErrors uint64
+	builds           uint64
+	shutdowns        uint64
+	shutdownErrors   uint64
+	shutdownDuration time.Duration
+}
+
+// Collector gathers container metrics and exposes them in the Prometheus text format.
+// Counters are fed by the event hooks of the injector, while gauges such as the number
+// of registered services are computed at scrape time.
+//
+// Collector implements http.Handler, so it can be mounted next to the debug UI:
+//
+//	collector := dometrics.New(injector)
+//	defer collector.Close()
+//
+//	mux.Handle("/metrics", collector)
+type Collector struct {
+	injector do.Injector
+
+	mu       sync.Mutex
+	services map[serviceKey]*serviceMetrics
+	checks   map[checkKey]*checkMetrics
+
+	unregister []func()
+}
+
+var _ http.Handler = (*Collector)(nil)
+
+// New creates a collector and registers its hooks on the root scope of the injector.
+// Call Close to unregister the hooks.
+func New(injector do.Injector) *Collector {
+	root := injector.RootScope()
+
+	c := &Collector{
+		injector: root,
+		mu:       sync.Mutex{},
+		services: map[serviceKey]*serviceMetrics{},
+		checks:   map[checkKey]*checkMetrics{},
+	}
+
+	c.unregister = []func(){
+		root.AddInvocationEventHook(c.onInvocation),
+		root.AddHealthCheckEventHook(c.onHealthCheck),
+		root.AddShutdownEventHook(c.onShutdown),
+	}
+
+	return c
+}
+
+// Close unregisters the hooks of the collector. Metrics collected so far are kept.
+func (c *Collector) Close() {
+	for _, unregister := range c.unregister {
+		unregister()
+	}
+}
+
+func newServiceKey(scope *do.Scope, serviceName string) serviceKey {
+	key := serviceKey{serviceName: serviceName}
+	if scope != nil {
+		key.scopeID = scope.ID()
+		key.scopeName = scope.Name()
+	}
+
+	return key
+}
+
+func (c *Collector) service(scope *do.Scope, serviceName string) *serviceMetrics {
+	key := newServiceKey(scope, serviceName)
+
+	metrics, ok := c.services[key]
+	if !ok {
+		metrics = &serviceMetrics{}
+		c.services[key] = metrics
+	}
+
+	return metrics
+}
+
+func (c *Collector) onInvocation(event do.InvocationEvent) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	metrics := c.service(event.Scope, event.ServiceName)
+	metrics.invocations++
+	if event.Error != nil {
+		metrics.invocationErrors++
+	}
+	if event.Built {
+		metrics.builds++
+	}
+}
+
+func (c *Collector) onHealthCheck(event do.HealthCheckEvent) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := checkKey{serviceKey: newServiceKey(event.Scope, event.ServiceName), kind: event.Kind}
+
+	metrics, ok := c.checks[key]
+	if !ok {
+		metrics = &checkMetrics{}
+		c.checks[key] = metrics
+	}
+
+	metrics.count++
+	metrics.duration = event.Duration
+	metrics.healthy = event.Error == nil
+	if event.Error != nil {
+		metrics.errors++
+	}
+}
+
+func (c *Collector) onShutdown(event do.ShutdownEvent) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	metrics := c.service(event.Scope, event.ServiceName)
+	metrics.shutdowns++
+	metrics.shutdownDuration = event.Duration
+	if event.Error != nil {
+		metrics.shutdownErrors++
+	}
+}
+
+// ServeHTTP writes the metrics in the Prometheus text format.
+func (c *Collector) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	var buf bytes.Buffer
+	if err := c.Write(&buf); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", ContentType)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(buf.Bytes())
+}
+
+// Write writes the metrics in the Prometheus text format.
+func (c *Collector) Write(w io.Writer) error {
+	families := append(c.scopeFamilies(), c.serviceFamilies()...)
+
+	for _, family := range families {
+		if err := family.write(w); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// scopeFamilies computes the gauges describing the current state of the scope tree.
+func (c *Collector) scopeFamilies() []*family {
+	registered := newFamily("do_services_registered", "gauge", "Number of services registered in a scope.")
+	built := newFamily("do_services_built", "gauge", "Number of services of a scope holding a built instance.")
+	invoked := newFamily("do_services_invoked", "gauge", "Number of services of a scope invoked at least once.")
+	buildTime := newFamily("do_service_build_seconds", "gauge", "Time spent building the service instance.")
+
+	var walk func(scopes []do.ExplainInjectorScopeOutput)
+	walk = func(scopes []do.ExplainInjectorScopeOutput) {
+		for _, scope := range scopes {
+			scopeLabels := []string{"scope_id", scope.ScopeID, "scope_name", scope.ScopeName}
+
+			builtCount := 0
+			for _, service := range scope.Services {
+				if service.ServiceBuildTime > 0 {
+					builtCount++
+					buildTime.add([]string{"scope_id", scope.ScopeID, "scope_name", scope.ScopeName, "service", service.ServiceName}, service.ServiceBuildTime.Seconds())
+				}
+			}
+
+			invokedCount := 0
+			for _, service := range scope.Scope.ListInvokedServices() {
+				if service.ScopeID == scope.ScopeID {
+					invokedCount++
+				}
+			}
+
+			registered.add(scopeLabels, float64(len(scope.Services)))
+			built.add(scopeLabels, float64(builtCount))
+			invoked.add(scopeLabels, float64(invokedCount))
+
+			walk(scope.Children)
+		}
+	}
+
+	walk(do.ExplainInjector(c.injector).DAG)
+
+	return []*family{registered, built, invoked, buildTime}
+}
+
+// serviceFamilies exports the counters fed by the event hooks.
+func (c *Collector) serviceFamilies() []*family {
+	invocations := newFamily("do_service_invocations_total", "counter", "Number of service invocations.")
+	invocationErrors := newFamily("do_service_invocation_errors_total", "counter", "Number of failed service invocations.")
+	builds := newFamily("do_service_builds_total", "counter", "Number of service instances built.")
+	healthChecks := newFamily("do_service_healthchecks_total", "counter", "Number of service health checks.")
+	healthCheckErrors := newFamily("do_service_healthcheck_errors_total", "counter", "Number of failed service health checks.")
+	healthCheckDuration := newFamily("do_service_healthcheck_duration_seconds", "gauge", "Duration of the last service health check.")
+	healthCheckStatus := newFamily("do_service_healthcheck_status", "gauge", "Status of the last service health check (1 = healthy, 0 = unhealthy).")
+	shutdowns := newFamily("do_service_shutdowns_total", "counter", "Number of service shutdowns.")
+	shutdownErrors := newFamily("do_service_shutdown_errors_total", "counter", "Number of failed service shutdowns.")
+	shutdownDuration := newFamily("do_service_shutdown_duration_seconds", "gauge", "Duration of the last service shutdown.")
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, metrics := range c.services {
+		labels := []string{"scope_id", key.scopeID, "scope_name", key.scopeName, "service", key.serviceName}
+
+		if metrics.invocations > 0 {
+			invocations.add(labels, float64(metrics.invocations))
+			invocationErrors.add(labels, float64(metrics.invocationErrors))
+			builds.add(labels, float64(metrics.builds))
+		}
+
+		if metrics.shutdowns > 0 {
+			shutdowns.add(labels, float64(metrics.shutdowns))
+			shutdownErrors.add(labels, float64(metrics.shutdownErrors))
+			shutdownDuration.add(labels, metrics.shutdownDuration.Seconds())
+		}
+	}
+
+	for key, metrics := range c.checks {
+		labels := []string{"scope_id", key.scopeID, "scope_name", key.scopeName, "service", key.serviceName, "check", string(key.kind)}
+
+		healthChecks.add(labels, float64(metrics.count))
+		healthCheckErrors.add(labels, float64(metrics.errors))
+		healthCheckDuration.add(labels, metrics.duration.Seconds())
+		healthCheckStatus.add(labels, boolToFloat(metrics.healthy))
+	}
+
+	return []*family{
+		invocations, invocationErrors, builds,
+		healthChecks, healthCheckErrors, healthCheckDuration, healthCheckStatus,
+		shutdowns, shutdownErrors, shutdownDuration,
+	}
+}
+
+// family is a metric family in the Prometheus text format.
+type family struct {
+	name    string
+	kind    string
+	help    string
+	samples []sample
+}
+
+type sample struct {
+	labels string
+	value  float64
+}
+
+func newFamily(name string, kind string, help string) *family {
+	return &family{name: name, kind: kind, help: help, samples: []sample{}}
+}
+
+// add appends a sample. Labels are provided as key-value pairs.
+func (f *family) add(labels []string, value float64) {
+	pairs := make([]string, 0, len(labels)/2)
+	for i := 0; i+1 < len(labels); i += 2 {
+		pairs = append(pairs, fmt.Sprintf(`%s="%s"`, labels[i], escapeLabelValue(labels[i+1])))
+	}
+
+	f.samples = append(f.samples, sample{labels: strings.Join(pairs, ","), value: value})
+}
+
+func (f *family) write(w io.Writer) error {
+	if len(f.samples) == 0 {
+		return nil
+	}
+
+	sort.Slice(f.samples, func(i, j int) bool {
+		return f.samples[i].labels < f.samples[j].labels
+	})
+
+	if _, err := fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", f.name, f.help, f.name, f.kind); err != nil {
+		return err
+	}
+
+	for _, s := range f.samples {
+		if _, err := fmt.Fprintf(w, "%s{%s} %s\n", f.name, s.labels, strconv.FormatFloat(s.value, 'g', -1, 64)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var labelValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func escapeLabelValue(value string) string {
+	return labelValueEscaper.Replace(value)
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
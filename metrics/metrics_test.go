@@ -0,0 +1,139 @@
+package dometrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	do "github.com/samber/do/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+type healthchecker struct{ err error }
+
+func (h *healthchecker) HealthCheck() error { return h.err }
+
+type shutdowner struct{ err error }
+
+func (s *shutdowner) Shutdown() error { return s.err }
+
+func scrape(t *testing.T, c *Collector) string {
+	t.Helper()
+
+	var buf strings.Builder
+	assert.NoError(t, c.Write(&buf))
+	return buf.String()
+}
+
+func TestCollector(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	injector := do.New()
+	collector := New(injector)
+	defer collector.Close()
+
+	child := injector.Scope("child")
+
+	do.ProvideNamed(injector, "lazy", func(i do.Injector) (int, error) { return 42, nil })
+	do.ProvideNamed(injector, "ko", func(i do.Injector) (string, error) { return "", errors.New("ko") })
+	do.ProvideNamedValue(child, "healthy", &healthchecker{})
+	do.ProvideNamedValue(child, "unhealthy", &healthchecker{err: errors.New("unhealthy")})
+	do.ProvideNamedValue(child, "shutdown", &shutdowner{err: errors.New("shutdown")})
+
+	_, _ = do.InvokeNamed[int](child, "lazy")
+	_, _ = do.InvokeNamed[int](child, "lazy")
+	_, _ = do.InvokeNamed[string](injector, "ko")
+	_ = child.HealthCheck()
+
+	root := `scope_id="` + injector.ID() + `",scope_name="[root]"`
+	scope := `scope_id="` + child.ID() + `",scope_name="child"`
+
+	output := scrape(t, collector)
+	is.Contains(output, "# HELP do_services_registered Number of services registered in a scope.\n# TYPE do_services_registered gauge\n")
+	is.Contains(output, "do_services_registered{"+root+"} 2\n")
+	is.Contains(output, "do_services_registered{"+scope+"} 3\n")
+	is.Contains(output, "do_services_built{"+root+"} 1\n")
+	is.Contains(output, "do_services_invoked{"+root+"} 1\n")
+	is.Contains(output, "do_service_build_seconds{"+root+`,service="lazy"} `)
+
+	is.Contains(output, "# TYPE do_service_invocations_total counter\n")
+	is.Contains(output, "do_service_invocations_total{"+root+`,service="lazy"} 2`+"\n")
+	is.Contains(output, "do_service_builds_total{"+root+`,service="lazy"} 1`+"\n")
+	is.Contains(output, "do_service_invocation_errors_total{"+root+`,service="lazy"} 0`+"\n")
+	is.Contains(output, "do_service_invocation_errors_total{"+root+`,service="ko"} 1`+"\n")
+
+	is.Contains(output, "do_service_healthchecks_total{"+scope+`,service="healthy",check="health"} 1`+"\n")
+	is.Contains(output, "do_service_healthcheck_status{"+scope+`,service="healthy",check="health"} 1`+"\n")
+	is.Contains(output, "do_service_healthcheck_status{"+scope+`,service="unhealthy",check="health"} 0`+"\n")
+	is.Contains(output, "do_service_healthcheck_errors_total{"+scope+`,service="unhealthy",check="health"} 1`+"\n")
+	is.Contains(output, "do_service_healthcheck_duration_seconds{"+scope+`,service="healthy",check="health"} `)
+	is.NotContains(output, "do_service_shutdowns_total")
+
+	_ = child.ReadinessCheck()
+	output = scrape(t, collector)
+	is.Contains(output, "do_service_healthchecks_total{"+scope+`,service="healthy",check="readiness"} 1`+"\n")
+	is.Contains(output, "do_service_healthchecks_total{"+scope+`,service="healthy",check="health"} 1`+"\n")
+
+	_ = injector.ShutdownWithContext(context.Background())
+
+	output = scrape(t, collector)
+	is.Contains(output, "do_service_shutdowns_total{"+scope+`,service="shutdown"} 1`+"\n")
+	is.Contains(output, "do_service_shutdown_errors_total{"+scope+`,service="shutdown"} 1`+"\n")
+	is.Contains(output, "do_service_shutdown_duration_seconds{"+scope+`,service="shutdown"} `)
+	is.Contains(output, "do_services_registered{"+root+"} 0\n")
+}
+
+func TestCollector_Close(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	injector := do.New()
+	collector := New(injector)
+
+	do.ProvideNamedValue(injector, "value", 42)
+	_, _ = do.InvokeNamed[int](injector, "value")
+
+	collector.Close()
+	_, _ = do.InvokeNamed[int](injector, "value")
+
+	is.Contains(scrape(t, collector), `service="value"} 1`+"\n")
+}
+
+func TestCollector_ServeHTTP(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	injector := do.New()
+	collector := New(injector)
+	defer collector.Close()
+
+	do.ProvideNamedValue(injector, "value", 42)
+
+	rec := httptest.NewRecorder()
+	collector.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	is.Equal(http.StatusOK, rec.Code)
+	is.Equal(ContentType, rec.Header().Get("Content-Type"))
+	is.Contains(rec.Body.String(), "do_services_registered{")
+}
+
+func TestEscapeLabelValue(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	is.Equal(`foo`, escapeLabelValue(`foo`))
+	is.Equal(`a\\b\"c\nd`, escapeLabelValue("a\\b\"c\nd"))
+
+	f := newFamily("foo", "gauge", "Foo.")
+	is.NoError(f.write(&strings.Builder{}))
+
+	f.add([]string{"b", "2"}, 2)
+	f.add([]string{"a", "1"}, 1.5)
+	var buf strings.Builder
+	is.NoError(f.write(&buf))
+	is.Equal("# HELP foo Foo.\n# TYPE foo gauge\nfoo{a=\"1\"} 1.5\nfoo{b=\"2\"} 2\n", buf.String())
+}
@@ -0,0 +1,232 @@
+package do
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PlanService is a service of a PlanWave.
+type PlanService struct {
+	ScopeID     string `json:"scope_id"`
+	ScopeName   string `json:"scope_name"`
+	ServiceName string `json:"service_name"`
+}
+
+// PlanWave is a group of services that are processed in parallel.
+type PlanWave struct {
+	Services []PlanService `json:"services"`
+
+	// Cycle is true when no service was ready, because of a circular dependency. The remaining
+	// services are then processed together, without taking care of order.
+	Cycle bool `json:"cycle,omitempty"`
+}
+
+// PlanDependency is a dependency between services of different scopes.
+type PlanDependency struct {
+	Dependent  PlanService `json:"dependent"`
+	Dependency PlanService `json:"dependency"`
+}
+
+// PlanConflict is a service shut down in spite of its ordering constraints: see ShutdownConflict.
+type PlanConflict struct {
+	Service PlanService   `json:"service"`
+	Waiting []PlanService `json:"waiting"`
+}
+
+// ShutdownPlanOutput is the order in which a scope and its descendants would be shut down: the
+// waves are shut down one after the other.
+type ShutdownPlanOutput struct {
+	ScopeID   string     `json:"scope_id"`
+	ScopeName string     `json:"scope_name"`
+	Waves     []PlanWave `json:"waves"`
+
+	// CrossScopeDependencies lists the dependencies between services of different scopes, that
+	// take precedence over the child-first rule.
+	CrossScopeDependencies []PlanDependency `json:"cross_scope_dependencies"`
+
+	// Conflicts lists the ordering constraints that cannot be honored.
+	Conflicts []PlanConflict `json:"conflicts"`
+}
+
+// HasCycle returns true when a wave is a dependency cycle.
+func (p ShutdownPlanOutput) HasCycle() bool {
+	return hasCycle(p.Waves)
+}
+
+// String returns a human-readable representation of the plan.
+func (p ShutdownPlanOutput) String() string {
+	lines := []string{fmt.Sprintf("%s (ID: %s)", p.ScopeName, p.ScopeID)}
+	lines = append(lines, wavesToLines(p.Waves, "  ")...)
+
+	for _, dependency := range p.CrossScopeDependencies {
+		lines = append(
+			lines,
+			fmt.Sprintf(
+				"  cross-scope dependency: %s > %s depends on %s > %s",
+				dependency.Dependent.ScopeName,
+				dependency.Dependent.ServiceName,
+				dependency.Dependency.ScopeName,
+				dependency.Dependency.ServiceName,
+			),
+		)
+	}
+
+	for _, conflict := range p.Conflicts {
+		waiting := mAp(conflict.Waiting, func(service PlanService, _ int) string {
+			return fmt.Sprintf("%s > %s", service.ScopeName, service.ServiceName)
+		})
+
+		lines = append(
+			lines,
+			fmt.Sprintf(
+				"  conflict: %s > %s is shut down before %s",
+				conflict.Service.ScopeName,
+				conflict.Service.ServiceName,
+				strings.Join(waiting, ", "),
+			),
+		)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func newShutdownPlanOutput(scope *Scope, order shutdownOrder) ShutdownPlanOutput {
+	return ShutdownPlanOutput{
+		ScopeID:   scope.id,
+		ScopeName: scope.name,
+		Waves: mAp(order.waves, func(wave shutdownWave, _ int) PlanWave {
+			return newPlanWave(wave.services, wave.cycle)
+		}),
+		CrossScopeDependencies: mAp(order.crossScopeDependencies, func(dependency ServiceDependency, _ int) PlanDependency {
+			return PlanDependency{
+				Dependent:  newPlanService(dependency.Dependent),
+				Dependency: newPlanService(dependency.Dependency),
+			}
+		}),
+		Conflicts: mAp(order.conflicts, func(conflict ShutdownConflict, _ int) PlanConflict {
+			return PlanConflict{
+				Service: newPlanService(conflict.Service),
+				Waiting: mAp(conflict.Waiting, func(desc ServiceDescription, _ int) PlanService {
+					return newPlanService(desc)
+				}),
+			}
+		}),
+	}
+}
+
+// BuildPlanOutput is the order in which the services of a root scope and its descendants would
+// be built: a service is built after its dependencies.
+type BuildPlanOutput struct {
+	Waves []PlanWave `json:"waves"`
+}
+
+// HasCycle returns true when a wave is a dependency cycle.
+func (p BuildPlanOutput) HasCycle() bool {
+	return hasCycle(p.Waves)
+}
+
+// String returns a human-readable representation of the plan.
+func (p BuildPlanOutput) String() string {
+	return strings.Join(wavesToLines(p.Waves, ""), "\n")
+}
+
+func hasCycle(waves []PlanWave) bool {
+	for _, wave := range waves {
+		if wave.Cycle {
+			return true
+		}
+	}
+
+	return false
+}
+
+func wavesToLines(waves []PlanWave, indent string) []string {
+	lines := []string{}
+
+	for i, wave := range waves {
+		names := mAp(wave.Services, func(service PlanService, _ int) string {
+			return fmt.Sprintf("%s > %s", service.ScopeName, service.ServiceName)
+		})
+
+		cycle := ""
+		if wave.Cycle {
+			cycle = " (circular dependency)"
+		}
+
+		lines = append(lines, fmt.Sprintf("%swave %d%s: %s", indent, i+1, cycle, strings.Join(names, ", ")))
+	}
+
+	return lines
+}
+
+func newPlanService(desc ServiceDescription) PlanService {
+	return PlanService{ScopeID: desc.ScopeID, ScopeName: desc.ScopeName, ServiceName: desc.Service}
+}
+
+func newPlanWave(services []ServiceDescription, cycle bool) PlanWave {
+	sortServiceDescriptions(services)
+
+	return PlanWave{
+		Services: mAp(services, func(desc ServiceDescription, _ int) PlanService {
+			return newPlanService(desc)
+		}),
+		Cycle: cycle,
+	}
+}
+
+// buildPlan orders the services of the scope and its descendants: a service is ready when its
+// dependencies, according to the DAG, are planned.
+func (s *Scope) buildPlan() BuildPlanOutput {
+	plan := BuildPlanOutput{
+		Waves: []PlanWave{},
+	}
+
+	pending := map[ServiceDescription]struct{}{}
+
+	var walk func(scope *Scope)
+	walk = func(scope *Scope) {
+		scope.mu.RLock()
+		for name := range scope.services {
+			pending[newServiceDescription(scope.id, scope.name, name)] = struct{}{}
+		}
+		scope.mu.RUnlock()
+
+		for _, child := range scope.Children() {
+			walk(child)
+		}
+	}
+	walk(s)
+
+	for len(pending) > 0 {
+		wave := []ServiceDescription{}
+
+		for desc := range pending {
+			dependencies, _ := s.rootScope.dag.explainService(desc.ScopeID, desc.ScopeName, desc.Service)
+
+			ready := true
+			for _, dependency := range dependencies {
+				if _, ok := pending[dependency]; ok && dependency != desc {
+					ready = false
+					break
+				}
+			}
+
+			if ready {
+				wave = append(wave, desc)
+			}
+		}
+
+		cycle := len(wave) == 0
+		if cycle {
+			wave = keys(pending)
+		}
+
+		for _, desc := range wave {
+			delete(pending, desc)
+		}
+
+		plan.Waves = append(plan.Waves, newPlanWave(wave, cycle))
+	}
+
+	return plan
+}
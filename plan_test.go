@@ -0,0 +1,159 @@
+package do
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func planTestInjector() (*RootScope, *Scope) {
+	injector := New()
+	child := injector.Scope("child")
+
+	provide := func(scope Injector, name string, dependencies ...string) {
+		ProvideNamed(scope, name, func(i Injector) (int, error) {
+			for _, dependency := range dependencies {
+				_ = MustInvokeNamed[int](i, dependency)
+			}
+			return 42, nil
+		})
+	}
+
+	provide(injector, "db")
+	provide(injector, "cache", "db")
+	provide(injector, "api", "cache", "db")
+	provide(child, "worker", "db")
+	provide(injector, "other")
+
+	_ = MustInvokeNamed[int](injector, "api")
+	_ = MustInvokeNamed[int](child, "worker")
+
+	return injector, child
+}
+
+func planTestWave(scope Injector, names ...string) []PlanService {
+	return mAp(names, func(name string, _ int) PlanService {
+		return PlanService{ScopeID: scope.ID(), ScopeName: scope.Name(), ServiceName: name}
+	})
+}
+
+func TestScope_ShutdownPlan(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	injector, child := planTestInjector()
+
+	plan := injector.ShutdownPlan()
+	is.Equal(injector.ID(), plan.ScopeID)
+	is.False(plan.HasCycle())
+
+	// children scopes first
+	is.Equal(
+		[]PlanWave{
+			{Services: planTestWave(child, "worker")},
+			{Services: planTestWave(injector, "api", "other")},
+			{Services: planTestWave(injector, "cache")},
+			{Services: planTestWave(injector, "db")},
+		},
+		plan.Waves,
+	)
+	is.Equal(
+		[]PlanDependency{
+			{Dependent: planTestWave(child, "worker")[0], Dependency: planTestWave(injector, "db")[0]},
+		},
+		plan.CrossScopeDependencies,
+	)
+
+	is.Equal(
+		"[root] (ID: "+injector.ID()+")\n"+
+			"  wave 1: child > worker\n"+
+			"  wave 2: [root] > api, [root] > other\n"+
+			"  wave 3: [root] > cache\n"+
+			"  wave 4: [root] > db\n"+
+			"  cross-scope dependency: child > worker depends on [root] > db",
+		plan.String(),
+	)
+
+	// a dry run: nothing is shut down
+	is.Len(injector.ListProvidedServices(), 4)
+
+	// the plan of a child scope ignores the parent services
+	is.Equal([]PlanWave{{Services: planTestWave(child, "worker")}}, child.ShutdownPlan().Waves)
+	is.Empty(child.ShutdownPlan().CrossScopeDependencies)
+
+	// the real shutdown follows the plan
+	report := injector.Shutdown()
+	is.True(report.Succeed)
+	is.Empty(injector.ShutdownPlan().Waves)
+}
+
+func TestScope_ShutdownPlan_cycle(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	injector := New()
+	ProvideNamedValue(injector, "a", 1)
+	ProvideNamedValue(injector, "b", 2)
+	ProvideNamedValue(injector, "c", 3)
+	injector.dag.addDependency(injector.ID(), injector.Name(), "a", injector.ID(), injector.Name(), "b")
+	injector.dag.addDependency(injector.ID(), injector.Name(), "b", injector.ID(), injector.Name(), "a")
+	injector.dag.addDependency(injector.ID(), injector.Name(), "c", injector.ID(), injector.Name(), "a")
+
+	plan := injector.ShutdownPlan()
+	is.True(plan.HasCycle())
+	is.Equal(
+		[]PlanWave{
+			{Services: planTestWave(injector, "c")},
+			{Services: planTestWave(injector, "a", "b"), Cycle: true},
+		},
+		plan.Waves,
+	)
+	is.Contains(plan.String(), "wave 2 (circular dependency): [root] > a, [root] > b")
+
+	buildPlan := injector.BuildPlan()
+	is.True(buildPlan.HasCycle())
+	is.Equal(
+		[]PlanWave{
+			{Services: planTestWave(injector, "a", "b", "c"), Cycle: true},
+		},
+		buildPlan.Waves,
+	)
+}
+
+func TestRootScope_BuildPlan(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	injector, child := planTestInjector()
+
+	plan := injector.BuildPlan()
+	is.False(plan.HasCycle())
+	is.Equal(
+		[]PlanWave{
+			{Services: planTestWave(injector, "db", "other")},
+			{Services: append(planTestWave(injector, "cache"), planTestWave(child, "worker")...)},
+			{Services: planTestWave(injector, "api")},
+		},
+		plan.Waves,
+	)
+
+	is.Equal(
+		"wave 1: [root] > db, [root] > other\n"+
+			"wave 2: [root] > cache, child > worker\n"+
+			"wave 3: [root] > api",
+		plan.String(),
+	)
+
+	output, err := json.Marshal(plan)
+	is.NoError(err)
+	is.JSONEq(`{"waves":[
+		{"services":[{"scope_id":"`+injector.ID()+`","scope_name":"[root]","service_name":"db"},{"scope_id":"`+injector.ID()+`","scope_name":"[root]","service_name":"other"}]},
+		{"services":[{"scope_id":"`+injector.ID()+`","scope_name":"[root]","service_name":"cache"},{"scope_id":"`+child.ID()+`","scope_name":"child","service_name":"worker"}]},
+		{"services":[{"scope_id":"`+injector.ID()+`","scope_name":"[root]","service_name":"api"}]}
+	]}`, string(output))
+}
@@ -0,0 +1,101 @@
+package do
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ReloadReport represents the result of a reload: see RootScope.Reload.
+type ReloadReport struct {
+	Succeed    bool
+	Services   []ServiceDescription // Reloaded services, in order
+	Errors     map[ServiceDescription]error
+	ReloadTime time.Duration
+}
+
+// Error implements the error interface for ReloadReport.
+// If there are errors, it returns a multiline description. Otherwise an empty string.
+func (r ReloadReport) Error() string {
+	lines := []string{}
+	for _, desc := range r.Services {
+		if err, ok := r.Errors[desc]; ok && err != nil {
+			lines = append(lines, fmt.Sprintf("  - %s > %s: %s", desc.ScopeName, desc.Service, err.Error()))
+		}
+	}
+
+	if len(lines) == 0 {
+		return ""
+	}
+
+	return "DI: reload errors:\n" + strings.Join(lines, "\n")
+}
+
+// reloadWithContext reloads the built services of the scope tree implementing Reloader, one at
+// a time, in the build order: see buildPlan. A failed reload does not stop the next ones.
+func (s *RootScope) reloadWithContext(ctx context.Context) *ReloadReport {
+	start := time.Now()
+
+	report := &ReloadReport{
+		Succeed:    true,
+		Services:   []ServiceDescription{},
+		Errors:     map[ServiceDescription]error{},
+		ReloadTime: 0,
+	}
+
+	scopes := s.scopesByID()
+
+	for _, wave := range s.self.buildPlan().Waves {
+		for _, service := range wave.Services {
+			scope, ok := scopes[service.ScopeID]
+			if !ok {
+				continue
+			}
+
+			serviceAny, ok := scope.serviceGet(service.ServiceName)
+			if !ok {
+				continue
+			}
+
+			reloader, ok := serviceReloader(serviceAny)
+			if !ok {
+				continue
+			}
+
+			desc := newServiceDescription(service.ScopeID, service.ScopeName, service.ServiceName)
+
+			serviceStart := time.Now()
+			err := ctx.Err()
+			if err == nil {
+				err = reloader.Reload(ctx)
+			}
+			s.opts.logService(logLevelError, "DI: service reloaded", scope, desc.Service, serviceAny, time.Since(serviceStart), err)
+
+			report.Services = append(report.Services, desc)
+			if err != nil {
+				report.Errors[desc] = err
+			}
+		}
+	}
+
+	report.ReloadTime = time.Since(start)
+	report.Succeed = len(report.Errors) == 0
+
+	return report
+}
+
+func serviceReloader(service any) (Reloader, bool) {
+	svc, ok := service.(serviceWrapperBuiltInstance)
+	if !ok {
+		return nil, false
+	}
+
+	instance, ok := svc.getBuiltInstance()
+	if !ok {
+		return nil, false
+	}
+
+	reloader, ok := instance.(Reloader)
+	return reloader, ok
+}
@@ -0,0 +1,80 @@
+package do
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var _ Reloader = (*reloadTestService)(nil)
+
+type reloadTestService struct {
+	name      string
+	recorder  *drainTestRecorder
+	reloadErr error
+}
+
+func (s *reloadTestService) Reload(ctx context.Context) error {
+	s.recorder.record("reload " + s.name)
+	return s.reloadErr
+}
+
+func TestRootScope_Reload(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	recorder := &drainTestRecorder{}
+	injector := New()
+	child := injector.Scope("child")
+
+	builds := 0
+	ProvideNamed(injector, "config", func(i Injector) (*reloadTestService, error) {
+		builds++
+		return &reloadTestService{name: "config", recorder: recorder}, nil
+	})
+	ProvideNamed(injector, "api", func(i Injector) (*reloadTestService, error) {
+		_ = MustInvokeNamed[*reloadTestService](i, "config")
+		return &reloadTestService{name: "api", recorder: recorder, reloadErr: assert.AnError}, nil
+	})
+	ProvideNamed(child, "worker", func(i Injector) (*reloadTestService, error) {
+		_ = MustInvokeNamed[*reloadTestService](i, "api")
+		return &reloadTestService{name: "worker", recorder: recorder}, nil
+	})
+	ProvideNamed(injector, "unused", func(i Injector) (*reloadTestService, error) {
+		return &reloadTestService{name: "unused", recorder: recorder}, nil
+	})
+	ProvideNamedValue(injector, "value", 42)
+
+	_ = MustInvokeNamed[*reloadTestService](child, "worker")
+
+	// dependencies first, and services that were never built are skipped
+	report := injector.Reload()
+	is.False(report.Succeed)
+	is.Equal([]string{"reload config", "reload api", "reload worker"}, recorder.list())
+	is.Equal(
+		[]ServiceDescription{
+			newServiceDescription(injector.ID(), injector.Name(), "config"),
+			newServiceDescription(injector.ID(), injector.Name(), "api"),
+			newServiceDescription(child.ID(), child.Name(), "worker"),
+		},
+		report.Services,
+	)
+	is.Equal(
+		map[ServiceDescription]error{newServiceDescription(injector.ID(), injector.Name(), "api"): assert.AnError},
+		report.Errors,
+	)
+	is.Equal("DI: reload errors:\n  - [root] > api: "+assert.AnError.Error(), report.Error())
+
+	// services are not rebuilt
+	is.Equal(1, builds)
+
+	// canceled context
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	report = injector.ReloadWithContext(ctx)
+	is.Len(report.Errors, 3)
+	is.Len(recorder.list(), 3)
+}
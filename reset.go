@@ -0,0 +1,113 @@
+package do
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// resetServicesInParallel resets the built services of the scope and its descendants, in the
+// shutdown order: see shutdownOrder. Unlike a shutdown, services stay registered.
+func (s *Scope) resetServicesInParallel(ctx context.Context) *ShutdownReport {
+	report := &ShutdownReport{
+		Succeed:                true,
+		Services:               []ServiceDescription{},
+		Errors:                 map[ServiceDescription]error{},
+		ShutdownTime:           0,
+		ServiceShutdownTime:    map[ServiceDescription]time.Duration{},
+		TimedOut:               []ServiceDescription{},
+		TransientInstances:     map[ServiceDescription]int{},
+		CrossScopeDependencies: []ServiceDependency{},
+		Conflicts:              []ShutdownConflict{},
+		Pending:                []ServiceDescription{},
+		RunnerErrors:           map[ServiceDescription]error{},
+	}
+
+	order := s.shutdownOrder()
+
+	for _, wave := range order.waves {
+		services := filter(wave.services, func(desc ServiceDescription, _ int) bool {
+			return isServiceResettable(order.scopes, desc)
+		})
+		if len(services) == 0 {
+			continue
+		}
+
+		r := s.rootScope.stopServicesInParallel(ctx, order.scopes, services, (*Scope).serviceReset)
+		report = mergeShutdownReports(report, r)
+	}
+
+	return report
+}
+
+// isServiceResettable returns true when the service holds an instance to release. Eager and
+// borrowed services are never reset.
+func isServiceResettable(scopes map[string]*Scope, desc ServiceDescription) bool {
+	scope, ok := scopes[desc.ScopeID]
+	if !ok {
+		return false
+	}
+
+	serviceAny, ok := scope.serviceGet(desc.Service)
+	if !ok || !getServiceOptions(serviceAny).isShutdownEnabled() {
+		return false
+	}
+
+	service, ok := serviceAny.(serviceWrapperReset)
+	return ok && service.isBuilt()
+}
+
+// serviceReset shuts down the instance of a service, and returns the service to its registered but
+// unbuilt state. Unlike serviceShutdown, the service is not removed from the scope.
+func (s *Scope) serviceReset(ctx context.Context, name string) error {
+	serviceAny, ok := s.serviceGet(name)
+	if !ok {
+		return serviceNotFound(s, ErrServiceNotFound, []string{name})
+	}
+
+	service, ok := serviceAny.(serviceWrapperReset)
+	if !ok {
+		return fmt.Errorf("DI: service `%s` cannot be reset", name)
+	}
+
+	s.logf("requested reset for service %s", name)
+
+	s.mu.Lock()
+	delete(s.orderedInvocation, name)
+	s.mu.Unlock()
+
+	return s.runServiceShutdown(ctx, name, serviceAny, service.reset)
+}
+
+// rebuildServices invokes again the lazy services of a reset report, dependencies first. It returns
+// the first error, and keeps building the next services.
+func (s *Scope) rebuildServices(report *ShutdownReport) error {
+	scopes := s.rootScope.scopesByID()
+
+	var firstErr error
+
+	// services are reset dependents first
+	for i := len(report.Services) - 1; i >= 0; i-- {
+		desc := report.Services[i]
+
+		scope, ok := scopes[desc.ScopeID]
+		if !ok {
+			continue
+		}
+
+		serviceAny, ok := scope.serviceGet(desc.Service)
+		if !ok {
+			continue
+		}
+
+		if svc, ok := serviceAny.(serviceWrapperGetServiceType); !ok || svc.getServiceType() != ServiceTypeLazy {
+			continue
+		}
+
+		if _, err := invokeAnyByName(scope, desc.Service); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
@@ -0,0 +1,174 @@
+package do
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScope_Reset(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	recorder := &drainTestRecorder{}
+	injector := New()
+	child := injector.Scope("child")
+
+	builds := 0
+	ProvideNamed(injector, "config", func(i Injector) (*drainTestService, error) {
+		builds++
+		return &drainTestService{name: "config", recorder: recorder}, nil
+	})
+	ProvideNamed(injector, "api", func(i Injector) (*drainTestService, error) {
+		_ = MustInvokeNamed[*drainTestService](i, "config")
+		return &drainTestService{name: "api", recorder: recorder}, nil
+	})
+	ProvideNamed(child, "worker", func(i Injector) (*drainTestService, error) {
+		_ = MustInvokeNamed[*drainTestService](i, "api")
+		return &drainTestService{name: "worker", recorder: recorder}, nil
+	})
+	ProvideNamed(injector, "unused", func(i Injector) (*drainTestService, error) {
+		return &drainTestService{name: "unused", recorder: recorder}, nil
+	})
+	ProvideNamed(injector, "shared", func(i Injector) (*drainTestService, error) {
+		return &drainTestService{name: "shared", recorder: recorder}, nil
+	}, WithOwnership(OwnershipBorrowed))
+	ProvideNamedValue(injector, "value", &drainTestService{name: "value", recorder: recorder})
+
+	_ = MustInvokeNamed[*drainTestService](child, "worker")
+	_ = MustInvokeNamed[*drainTestService](injector, "shared")
+	_ = MustInvokeNamed[*drainTestService](injector, "value")
+
+	// dependents first, and eager, borrowed or unbuilt services are kept
+	report := injector.Reset()
+	is.True(report.Succeed)
+	is.Equal([]string{"shutdown worker", "shutdown api", "shutdown config"}, recorder.list())
+	is.Equal(
+		[]ServiceDescription{
+			newServiceDescription(child.ID(), child.Name(), "worker"),
+			newServiceDescription(injector.ID(), injector.Name(), "api"),
+			newServiceDescription(injector.ID(), injector.Name(), "config"),
+		},
+		report.Services,
+	)
+
+	// registrations and children scopes are kept
+	is.ElementsMatch(
+		[]ServiceDescription{
+			newServiceDescription(injector.ID(), injector.Name(), "config"),
+			newServiceDescription(injector.ID(), injector.Name(), "api"),
+			newServiceDescription(injector.ID(), injector.Name(), "unused"),
+			newServiceDescription(injector.ID(), injector.Name(), "shared"),
+			newServiceDescription(injector.ID(), injector.Name(), "value"),
+		},
+		injector.ListProvidedServices(),
+	)
+	is.Len(injector.Children(), 1)
+	is.ElementsMatch(
+		[]ServiceDescription{
+			newServiceDescription(injector.ID(), injector.Name(), "shared"),
+			newServiceDescription(injector.ID(), injector.Name(), "value"),
+		},
+		injector.ListInvokedServices(),
+	)
+
+	// services are built again on invocation
+	_ = MustInvokeNamed[*drainTestService](child, "worker")
+	is.Equal(2, builds)
+
+	// nothing is left to reset
+	_ = injector.Reset()
+	report = injector.Reset()
+	is.True(report.Succeed)
+	is.Empty(report.Services)
+}
+
+func TestScope_Reset_transient(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	shutdown := []int{}
+	count := 0
+
+	injector := New()
+	ProvideTransient(injector, func(i Injector) (*transientTestTracked, error) {
+		count++
+		return &transientTestTracked{id: count, shutdown: &shutdown}, nil
+	}, WithTransientTracking())
+
+	_ = MustInvoke[*transientTestTracked](injector)
+	_ = MustInvoke[*transientTestTracked](injector)
+
+	report := injector.Reset()
+	is.True(report.Succeed)
+	is.Equal([]int{2, 1}, shutdown)
+	is.Equal(map[ServiceDescription]int{newServiceDescription(injector.ID(), injector.Name(), NameOf[*transientTestTracked]()): 2}, report.TransientInstances)
+
+	_ = MustInvoke[*transientTestTracked](injector)
+	is.Equal(3, count)
+}
+
+func TestScope_Restart(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	recorder := &drainTestRecorder{}
+	injector := New()
+	child := injector.Scope("child")
+
+	builds := map[string]int{}
+	provide := func(scope Injector, name string, dependency string, err error) {
+		ProvideNamed(scope, name, func(i Injector) (*drainTestService, error) {
+			if dependency != "" {
+				if _, e := InvokeNamed[*drainTestService](i, dependency); e != nil {
+					return nil, e
+				}
+			}
+			builds[name]++
+			if builds[name] > 1 && err != nil {
+				return nil, err
+			}
+			return &drainTestService{name: name, recorder: recorder}, nil
+		})
+	}
+
+	provide(injector, "config", "", nil)
+	provide(injector, "api", "config", nil)
+	provide(child, "worker", "api", nil)
+	provide(injector, "unused", "", nil)
+
+	_ = MustInvokeNamed[*drainTestService](child, "worker")
+
+	report, err := injector.Restart()
+	is.NoError(err)
+	is.True(report.Succeed)
+	is.Len(report.Services, 3)
+	is.Equal(map[string]int{"config": 2, "api": 2, "worker": 2}, builds)
+	is.ElementsMatch(
+		[]ServiceDescription{
+			newServiceDescription(injector.ID(), injector.Name(), "config"),
+			newServiceDescription(injector.ID(), injector.Name(), "api"),
+		},
+		injector.ListInvokedServices(),
+	)
+
+	// a failing provider does not prevent the next services from being built
+	injector = New()
+	provide(injector, "broken", "", assert.AnError)
+	provide(injector, "healthy", "", nil)
+	_ = MustInvokeNamed[*drainTestService](injector, "broken")
+	_ = MustInvokeNamed[*drainTestService](injector, "healthy")
+
+	report, err = injector.Restart()
+	is.ErrorIs(err, assert.AnError)
+	is.True(report.Succeed)
+	is.Equal(2, builds["healthy"])
+	is.ElementsMatch(
+		[]ServiceDescription{newServiceDescription(injector.ID(), injector.Name(), "healthy")},
+		injector.ListInvokedServices(),
+	)
+}
@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
+	"time"
 )
 
 // DefaultRootScopeName is the default name for the root scope.
@@ -85,12 +87,39 @@ func NewWithOpts(opts *InjectorOpts, packages ...func(Injector)) *RootScope {
 	if opts.HookAfterShutdown == nil {
 		opts.HookAfterShutdown = []func(*Scope, string, error){}
 	}
+	if opts.registrationEventHooks == nil {
+		opts.registrationEventHooks = newHookRegistry(opts.HookRegistrationEvent)
+	}
+	if opts.invocationEventHooks == nil {
+		opts.invocationEventHooks = newHookRegistry(opts.HookInvocationEvent)
+	}
+	if opts.healthCheckEventHooks == nil {
+		opts.healthCheckEventHooks = newHookRegistry(opts.HookHealthCheckEvent)
+	}
+	if opts.shutdownEventHooks == nil {
+		opts.shutdownEventHooks = newHookRegistry(opts.HookShutdownEvent)
+	}
+	if opts.invocationMiddlewares == nil {
+		opts.invocationMiddlewares = newMiddlewareRegistry(opts.InvocationMiddleware)
+	}
 
 	root := &RootScope{
 		self:            newScope(DefaultRootScopeName, nil, nil),
 		opts:            opts,
 		dag:             newDAG(),
 		healthCheckPool: nil,
+		healthMonitorMu: sync.Mutex{},
+		healthMonitor:   nil,
+
+		shutdownPoolMu:    sync.Mutex{},
+		shutdownPool:      nil,
+		shutdownPoolUsers: 0,
+
+		inFlightShutdownsMu: sync.Mutex{},
+		inFlightShutdowns:   map[ServiceDescription]struct{}{},
+
+		runnersMu: sync.Mutex{},
+		runners:   map[ServiceDescription]*supervisedRunner{},
 	}
 	root.self.rootScope = root
 
@@ -100,6 +129,7 @@ func NewWithOpts(opts *InjectorOpts, packages ...func(Injector)) *RootScope {
 	}
 
 	root.opts.Logf("DI: injector created")
+	root.opts.logScope(logLevelDebug, "DI: injector created", root.self)
 
 	for _, pkg := range packages {
 		pkg(root)
@@ -125,6 +155,19 @@ type RootScope struct {
 	opts            *InjectorOpts   // Configuration options
 	dag             *DAG            // Dependency graph for service relationships
 	healthCheckPool *jobPool[error] // Pool for parallel health check operations
+
+	shutdownPoolMu    sync.Mutex
+	shutdownPool      *jobPool[error] // Pool for parallel shutdown operations, running while a shutdown is in progress
+	shutdownPoolUsers int             // Number of shutdowns using the pool
+
+	healthMonitorMu sync.Mutex
+	healthMonitor   *HealthMonitor // Background health checks, started by StartHealthMonitor
+
+	inFlightShutdownsMu sync.Mutex
+	inFlightShutdowns   map[ServiceDescription]struct{} // Services removed from their scope, and being shut down
+
+	runnersMu sync.Mutex
+	runners   map[ServiceDescription]*supervisedRunner // Services implementing Runner, see superviseService
 }
 
 // Pass-through methods that delegate to the underlying scope
@@ -168,12 +211,51 @@ func (s *RootScope) HealthCheckWithContext(ctx context.Context) map[string]error
 	return s.self.HealthCheckWithContext(ctx)
 }
 
+// ReadinessCheck performs readiness checks on all services in the root scope.
+func (s *RootScope) ReadinessCheck() map[string]error { return s.self.ReadinessCheck() }
+
+// ReadinessCheckWithContext performs readiness checks with context support for cancellation and timeouts.
+func (s *RootScope) ReadinessCheckWithContext(ctx context.Context) map[string]error {
+	return s.self.ReadinessCheckWithContext(ctx)
+}
+
+// LivenessCheck performs liveness checks on all services in the root scope.
+func (s *RootScope) LivenessCheck() map[string]error { return s.self.LivenessCheck() }
+
+// LivenessCheckWithContext performs liveness checks with context support for cancellation and timeouts.
+func (s *RootScope) LivenessCheckWithContext(ctx context.Context) map[string]error {
+	return s.self.LivenessCheckWithContext(ctx)
+}
+
+// HealthCheckTree performs health checks on all services of the root scope and its descendants.
+func (s *RootScope) HealthCheckTree() HealthCheckTreeOutput { return s.self.HealthCheckTree() }
+
+// HealthCheckTreeWithContext performs health checks on all services of the root scope and its
+// descendants, with context support for cancellation and timeouts.
+func (s *RootScope) HealthCheckTreeWithContext(ctx context.Context) HealthCheckTreeOutput {
+	return s.self.HealthCheckTreeWithContext(ctx)
+}
+
+// HealthReport checks the services of the root scope, and returns a structured report.
+func (s *RootScope) HealthReport(opts HealthReportOpts) *HealthReport { return s.self.HealthReport(opts) }
+
+// HealthReportWithContext checks the services of the root scope with context support for cancellation and timeouts.
+func (s *RootScope) HealthReportWithContext(ctx context.Context, opts HealthReportOpts) *HealthReport {
+	return s.self.HealthReportWithContext(ctx, opts)
+}
+
 // Shutdown gracefully shuts down the root scope and all its descendant scopes.
 func (s *RootScope) Shutdown() *ShutdownReport { return s.ShutdownWithContext(context.Background()) }
 
 // ShutdownWithContext gracefully shuts down the root scope and all its descendant scopes with context support.
 // This method ensures proper cleanup of the health check pool and all registered services.
+//
+// The shutdown runs in two phases, each with its own budget (see InjectorOpts.DrainPhaseTimeout and
+// InjectorOpts.ShutdownPhaseTimeout): services implementing Drainer are drained first, then services
+// are shut down. Both phases follow the dependency order.
 func (s *RootScope) ShutdownWithContext(ctx context.Context) *ShutdownReport {
+	s.StopHealthMonitor()
+
 	defer func() {
 		if s.healthCheckPool != nil {
 			s.healthCheckPool.stop()
@@ -181,9 +263,69 @@ func (s *RootScope) ShutdownWithContext(ctx context.Context) *ShutdownReport {
 		}
 	}()
 
-	return s.self.ShutdownWithContext(ctx)
+	start := time.Now()
+
+	drain := runShutdownPhase(ctx, ShutdownPhaseDrain, s.opts.DrainPhaseTimeout, s.self.drainWithContext)
+
+	var report *ShutdownReport
+	shutdown := runShutdownPhase(ctx, ShutdownPhaseShutdown, s.opts.ShutdownPhaseTimeout, func(ctx context.Context) *ShutdownPhaseReport {
+		report = s.self.ShutdownWithContext(ctx)
+		return &ShutdownPhaseReport{
+			Phase:    ShutdownPhaseShutdown,
+			Budget:   0,
+			Duration: 0,
+			Exceeded: false,
+			Services: report.Services,
+			Errors:   report.Errors,
+		}
+	})
+
+	report.Phases = []ShutdownPhaseReport{drain, shutdown}
+	report.ShutdownTime = time.Since(start)
+	report.Succeed = len(report.Errors) == 0 && len(drain.Errors) == 0
+
+	return report
+}
+
+// Reload reloads the services implementing Reloader. See ReloadWithContext.
+func (s *RootScope) Reload() *ReloadReport {
+	return s.ReloadWithContext(context.Background())
+}
+
+// ReloadWithContext calls the Reload method of the built services implementing Reloader, in the
+// root scope and its descendants, one at a time and in dependency order: dependencies first.
+// Services are not rebuilt, and services that were never invoked are skipped.
+func (s *RootScope) ReloadWithContext(ctx context.Context) *ReloadReport {
+	s.opts.Logf("DI: requested reload")
+	return s.reloadWithContext(ctx)
+}
+
+// Reset returns the services of the root scope and its descendants to their registered but unbuilt state.
+func (s *RootScope) Reset() *ShutdownReport { return s.self.Reset() }
+
+// ResetWithContext returns the services of the root scope and its descendants to their registered but
+// unbuilt state, with context support. See Scope.ResetWithContext.
+func (s *RootScope) ResetWithContext(ctx context.Context) *ShutdownReport {
+	return s.self.ResetWithContext(ctx)
 }
 
+// Restart resets the root scope and its descendants, then builds again the lazy services that were built.
+func (s *RootScope) Restart() (*ShutdownReport, error) { return s.self.Restart() }
+
+// RestartWithContext resets the root scope and its descendants, then builds again the lazy services
+// that were built, with context support. See Scope.RestartWithContext.
+func (s *RootScope) RestartWithContext(ctx context.Context) (*ShutdownReport, error) {
+	return s.self.RestartWithContext(ctx)
+}
+
+// ShutdownPlan returns the order in which the root scope and its descendants would be shut down.
+func (s *RootScope) ShutdownPlan() ShutdownPlanOutput { return s.self.ShutdownPlan() }
+
+// BuildPlan returns the order in which the services of the root scope and its descendants would be
+// built, dependencies first. Dependencies are discovered on invocation: services that were never
+// invoked have no known dependency, and are planned in the first wave.
+func (s *RootScope) BuildPlan() BuildPlanOutput { return s.self.buildPlan() }
+
 func (s *RootScope) clone(root *RootScope, parent *Scope) *Scope      { return s.self.clone(root, parent) }
 func (s *RootScope) serviceExist(name string) bool                    { return s.self.serviceExist(name) }
 func (s *RootScope) serviceExistRec(name string) bool                 { return s.self.serviceExistRec(name) }
@@ -200,12 +342,99 @@ func (s *RootScope) serviceHealthCheck(ctx context.Context, name string) error {
 	return s.self.serviceHealthCheck(ctx, name)
 }
 
+func (s *RootScope) serviceCheck(ctx context.Context, name string, kind HealthCheckKind) error {
+	return s.self.serviceCheck(ctx, name, kind)
+}
+
 func (s *RootScope) serviceShutdown(ctx context.Context, name string) error {
 	return s.self.serviceShutdown(ctx, name)
 }
 func (s *RootScope) onServiceInvoke(name string) { s.self.onServiceInvoke(name) }
 
 func (s *RootScope) queueServiceHealthcheck(ctx context.Context, scope *Scope, serviceName string) <-chan error {
+	return s.queueServiceCheck(ctx, scope, serviceName, HealthCheckKindHealth)
+}
+
+// runShutdownJobs runs count jobs, through the shutdown pool when ShutdownParallelism is set, and
+// returns their results. Jobs are queued in order: with a parallelism of 1, they run sequentially.
+func (s *RootScope) runShutdownJobs(count int, job func(int) error) []error {
+	pool := s.acquireShutdownPool()
+	defer s.releaseShutdownPool(pool)
+
+	queued := make([]<-chan error, count)
+
+	for i := 0; i < count; i++ {
+		i := i
+
+		// when no pooling policy has been defined
+		if s.opts.ShutdownParallelism == 0 || pool == nil {
+			c := make(chan error, 1)
+			go func() {
+				defer close(c)
+				c <- job(i)
+			}()
+			queued[i] = c
+			continue
+		}
+
+		queued[i] = pool.rpc(func() error { return job(i) })
+	}
+
+	return mAp(queued, func(c <-chan error, _ int) error {
+		return <-c
+	})
+}
+
+// acquireShutdownPool returns the shutdown pool, and starts it when no shutdown is running. The
+// pool is shared by concurrent shutdowns and resets, so that ShutdownParallelism bounds all of them.
+// It returns nil when ShutdownParallelism is not set.
+func (s *RootScope) acquireShutdownPool() *jobPool[error] {
+	if s.opts.ShutdownParallelism == 0 {
+		return nil
+	}
+
+	s.shutdownPoolMu.Lock()
+	defer s.shutdownPoolMu.Unlock()
+
+	if s.shutdownPool == nil {
+		s.shutdownPool = newJobPool[error](s.opts.ShutdownParallelism)
+		s.shutdownPool.start()
+	}
+	s.shutdownPoolUsers++
+
+	return s.shutdownPool
+}
+
+// releaseShutdownPool stops the shutdown pool once the last shutdown using it is over.
+func (s *RootScope) releaseShutdownPool(pool *jobPool[error]) {
+	if pool == nil {
+		return
+	}
+
+	s.shutdownPoolMu.Lock()
+	defer s.shutdownPoolMu.Unlock()
+
+	s.shutdownPoolUsers--
+	if s.shutdownPoolUsers == 0 {
+		s.shutdownPool.stop()
+		s.shutdownPool = nil
+	}
+}
+
+// queueServiceCheck runs a check of the given kind on a service, with the HealthCheckTimeout
+// option, through the health check pool when HealthCheckParallelism is set.
+func (s *RootScope) queueServiceCheck(ctx context.Context, scope *Scope, serviceName string, kind HealthCheckKind) <-chan error {
+	return s.QueueHealthCheck(ctx, func(ctx context.Context) error {
+		return scope.serviceCheck(ctx, serviceName, kind)
+	})
+}
+
+// QueueHealthCheck runs a custom check with the HealthCheckTimeout option, through the health
+// check pool when HealthCheckParallelism is set. Integrations running their own checks, such as
+// HTTP probes, share the concurrency limit of the injector this way.
+//
+// Returns a channel receiving the result of the check.
+func (s *RootScope) QueueHealthCheck(ctx context.Context, check func(context.Context) error) <-chan error {
 	cancel := func() {}
 	if s.opts.HealthCheckTimeout > 0 {
 		// `ctx` might already contain a timeout, but we add another one
@@ -222,7 +451,7 @@ func (s *RootScope) queueServiceHealthcheck(ctx context.Context, scope *Scope, s
 			select {
 			case e := <-func() chan error {
 				c := make(chan error, 1)
-				go func() { c <- scope.serviceHealthCheck(ctx, serviceName) }()
+				go func() { c <- check(ctx) }()
 				return c
 			}():
 				err <- e
@@ -239,7 +468,7 @@ func (s *RootScope) queueServiceHealthcheck(ctx context.Context, scope *Scope, s
 	// delegate execution to the healthcheck pool
 	return s.healthCheckPool.rpc(func() error {
 		defer cancel()
-		return scope.serviceHealthCheck(ctx, serviceName)
+		return check(ctx)
 	})
 }
 
@@ -247,6 +476,49 @@ func (s *RootScope) queueServiceHealthcheck(ctx context.Context, scope *Scope, s
  * RootScope stuff
  */
 
+// StartHealthMonitor starts checking the services of the scope tree in the background,
+// at the interval defined in the options. The latest result of each service is cached,
+// and can be read with HealthMonitor.Snapshot instead of running the checks on every request.
+//
+// A root scope runs a single monitor: a previously started monitor is stopped.
+// The monitor is stopped when the root scope shuts down.
+func (s *RootScope) StartHealthMonitor(opts HealthMonitorOpts) *HealthMonitor {
+	monitor := newHealthMonitor(s, opts)
+
+	s.healthMonitorMu.Lock()
+	previous := s.healthMonitor
+	s.healthMonitor = monitor
+	s.healthMonitorMu.Unlock()
+
+	if previous != nil {
+		previous.Stop()
+	}
+
+	monitor.start()
+
+	return monitor
+}
+
+// StopHealthMonitor stops the health monitor, if any.
+func (s *RootScope) StopHealthMonitor() {
+	s.healthMonitorMu.Lock()
+	monitor := s.healthMonitor
+	s.healthMonitor = nil
+	s.healthMonitorMu.Unlock()
+
+	if monitor != nil {
+		monitor.Stop()
+	}
+}
+
+// HealthMonitor returns the running health monitor, if any.
+func (s *RootScope) HealthMonitor() (*HealthMonitor, bool) {
+	s.healthMonitorMu.Lock()
+	defer s.healthMonitorMu.Unlock()
+
+	return s.healthMonitor, s.healthMonitor != nil
+}
+
 // AddBeforeRegistrationHook adds a hook that will be called before a service is registered.
 //
 // Play: https://go.dev/play/p/IstT_4oovQD
@@ -289,6 +561,36 @@ func (s *RootScope) AddAfterShutdownHook(hook func(*Scope, string, error)) {
 	s.opts.HookAfterShutdown = append(s.opts.HookAfterShutdown, hook)
 }
 
+// AddInvocationMiddleware appends a middleware wrapping the resolution of every invoked service.
+// The middleware is the innermost one, as it is called after previously registered middlewares.
+func (s *RootScope) AddInvocationMiddleware(middleware func(next Resolver) Resolver) {
+	s.opts.invocationMiddlewares.add(middleware)
+}
+
+// AddRegistrationEventHook adds a hook that will be called after a service is registered,
+// with a detailed event. It returns a function that unregisters the hook.
+func (s *RootScope) AddRegistrationEventHook(hook func(RegistrationEvent)) (unregister func()) {
+	return s.opts.registrationEventHooks.add(hook)
+}
+
+// AddInvocationEventHook adds a hook that will be called after a service is invoked,
+// with a detailed event. It returns a function that unregisters the hook.
+func (s *RootScope) AddInvocationEventHook(hook func(InvocationEvent)) (unregister func()) {
+	return s.opts.invocationEventHooks.add(hook)
+}
+
+// AddHealthCheckEventHook adds a hook that will be called after a service is health checked,
+// with a detailed event. It returns a function that unregisters the hook.
+func (s *RootScope) AddHealthCheckEventHook(hook func(HealthCheckEvent)) (unregister func()) {
+	return s.opts.healthCheckEventHooks.add(hook)
+}
+
+// AddShutdownEventHook adds a hook that will be called after a service is shut down,
+// with a detailed event. It returns a function that unregisters the hook.
+func (s *RootScope) AddShutdownEventHook(hook func(ShutdownEvent)) (unregister func()) {
+	return s.opts.shutdownEventHooks.add(hook)
+}
+
 // Clone clones injector with provided services but not with invoked instances.
 //
 // Play: https://go.dev/play/p/DqIlXhZ8c4t
@@ -304,6 +606,7 @@ func (s *RootScope) CloneWithOpts(opts *InjectorOpts) *RootScope {
 	clone.self = s.clone(clone, nil)
 
 	s.opts.Logf("DI: injector cloned")
+	s.opts.logScope(logLevelDebug, "DI: injector cloned", s.self)
 
 	return clone
 }
@@ -319,26 +622,218 @@ func (s *RootScope) ShutdownOnSignals(signals ...os.Signal) (os.Signal, *Shutdow
 // It will block until receiving any of these signals.
 // If no signal is provided, syscall.SIGTERM and os.Interrupt will be handled by default.
 func (s *RootScope) ShutdownOnSignalsWithContext(ctx context.Context, signals ...os.Signal) (os.Signal, *ShutdownReport) {
+	return s.ShutdownOnSignalsWithOpts(ctx, ShutdownOnSignalsOpts{Signals: signals})
+}
+
+// ShutdownOnSignalsOpts configures RootScope.ShutdownOnSignalsWithOpts.
+type ShutdownOnSignalsOpts struct {
+	// Signals triggering the shutdown. Defaults to syscall.SIGTERM and os.Interrupt.
+	Signals []os.Signal
+
+	// GraceTimeout bounds the graceful shutdown. When it expires, the shutdown is forced.
+	// 0 means no timeout.
+	GraceTimeout time.Duration
+
+	// ForceOnSecondSignal forces the shutdown when one of Signals is received again during the
+	// graceful shutdown, such as a second Ctrl+C.
+	ForceOnSecondSignal bool
+
+	// OnForcedShutdown is called when the shutdown is forced, before exiting, with a report listing
+	// the services still pending in ShutdownReport.Pending.
+	OnForcedShutdown func(os.Signal, *ShutdownReport)
+
+	// ExitCode is the exit code of the process on a forced shutdown. Defaults to 1.
+	ExitCode int
+
+	// Exit is called on a forced shutdown. Defaults to os.Exit.
+	Exit func(code int)
+
+	// ReloadSignals, such as syscall.SIGHUP, reload the services implementing Reloader, without
+	// stopping the process. See RootScope.Reload.
+	ReloadSignals []os.Signal
+
+	// OnReload is called after each reload.
+	OnReload func(os.Signal, *ReloadReport)
+}
+
+// ShutdownOnSignalsWithOpts listens for signals in order to gracefully stop services, like
+// ShutdownOnSignalsWithContext, with escalation: the shutdown is forced after a grace timeout, or
+// on a second signal. A forced shutdown exits the process, after reporting the services still
+// pending. When opts.Exit returns, the report of the forced shutdown is returned, and the pending
+// services keep shutting down in the background, with a canceled context.
+//
+// Reload signals are handled until the shutdown starts.
+func (s *RootScope) ShutdownOnSignalsWithOpts(ctx context.Context, opts ShutdownOnSignalsOpts) (os.Signal, *ShutdownReport) {
 	// Make sure there is at least syscall.SIGTERM and os.Interrupt as a signal
-	if len(signals) < 1 {
-		signals = append(signals, syscall.SIGTERM, os.Interrupt)
+	if len(opts.Signals) < 1 {
+		opts.Signals = []os.Signal{syscall.SIGTERM, os.Interrupt}
 	}
 
 	ch := make(chan os.Signal, 5)
-	signal.Notify(ch, signals...)
+	signal.Notify(ch, opts.Signals...)
+	defer signal.Stop(ch)
 
+	reloadCh := make(chan os.Signal, 5)
+	if len(opts.ReloadSignals) > 0 {
+		signal.Notify(reloadCh, opts.ReloadSignals...)
+	}
+	defer signal.Stop(reloadCh)
+
+	return s.shutdownOnSignals(ctx, opts, ch, reloadCh, func() {
+		signal.Stop(reloadCh)
+		if !opts.ForceOnSecondSignal {
+			// Restore the default behavior: a second signal kills the process.
+			signal.Stop(ch)
+		}
+	})
+}
+
+// shutdownOnSignals implements ShutdownOnSignalsWithOpts, on channels that can be fed by tests.
+// onShutdown is called when the shutdown starts.
+func (s *RootScope) shutdownOnSignals(ctx context.Context, opts ShutdownOnSignalsOpts, ch <-chan os.Signal, reloadCh <-chan os.Signal, onShutdown func()) (os.Signal, *ShutdownReport) {
 	var sig os.Signal
+	for sig == nil {
+		select {
+		case sig = <-ch:
+			// got a signal
+		case reloadSig := <-reloadCh:
+			report := s.ReloadWithContext(ctx)
+			if opts.OnReload != nil {
+				opts.OnReload(reloadSig, report)
+			}
+		case <-ctx.Done():
+			onShutdown()
+			return nil, s.ShutdownWithContext(ctx)
+		}
+	}
+
+	onShutdown()
+
+	if opts.GraceTimeout <= 0 && !opts.ForceOnSecondSignal {
+		return sig, s.ShutdownWithContext(ctx)
+	}
+
+	var timeout <-chan time.Time
+	if opts.GraceTimeout > 0 {
+		timer := time.NewTimer(opts.GraceTimeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	var second <-chan os.Signal
+	if opts.ForceOnSecondSignal {
+		second = ch
+	}
+
+	start := time.Now()
+	services := s.pendingShutdowns()
+
+	shutdownCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	done := make(chan *ShutdownReport, 1)
+	go func() {
+		done <- s.ShutdownWithContext(shutdownCtx)
+	}()
+
 	select {
-	case sig = <-ch:
-		// got a signal
-	case <-ctx.Done():
-		signal.Stop(ch)
-		close(ch)
-		return nil, s.ShutdownWithContext(ctx)
+	case report := <-done:
+		return sig, report
+	case <-timeout:
+		s.opts.Logf("DI: graceful shutdown timeout exceeded, forcing shutdown")
+	case <-second:
+		s.opts.Logf("DI: second signal received, forcing shutdown")
+	}
+
+	report := s.forcedShutdownReport(services, time.Since(start))
+	s.opts.Logf("DI: %d services still pending", len(report.Pending))
+
+	if opts.OnForcedShutdown != nil {
+		opts.OnForcedShutdown(sig, report)
+	}
+
+	code := opts.ExitCode
+	if code == 0 {
+		code = 1
+	}
+
+	exit := opts.Exit
+	if exit == nil {
+		exit = os.Exit
 	}
+	exit(code)
+
+	return sig, report
+}
 
-	signal.Stop(ch)
-	close(ch)
+// pendingShutdowns returns the services of the scope tree that are not shut down yet: registered
+// services, and services being shut down.
+func (s *RootScope) pendingShutdowns() []ServiceDescription {
+	pending := map[ServiceDescription]struct{}{}
 
-	return sig, s.ShutdownWithContext(ctx)
+	for _, scope := range s.scopesByID() {
+		scope.mu.RLock()
+		for name := range scope.services {
+			pending[newServiceDescription(scope.id, scope.name, name)] = struct{}{}
+		}
+		scope.mu.RUnlock()
+	}
+
+	s.inFlightShutdownsMu.Lock()
+	for desc := range s.inFlightShutdowns {
+		pending[desc] = struct{}{}
+	}
+	s.inFlightShutdownsMu.Unlock()
+
+	services := keys(pending)
+	sortServiceDescriptions(services)
+
+	return services
+}
+
+// forcedShutdownReport reports a forced shutdown: the services pending at the start of the
+// shutdown, and still pending, fail with ErrShutdownForced.
+func (s *RootScope) forcedShutdownReport(services []ServiceDescription, duration time.Duration) *ShutdownReport {
+	pending := map[ServiceDescription]struct{}{}
+	for _, desc := range s.pendingShutdowns() {
+		pending[desc] = struct{}{}
+	}
+
+	report := &ShutdownReport{
+		Succeed:                false,
+		Services:               []ServiceDescription{},
+		Errors:                 map[ServiceDescription]error{},
+		ShutdownTime:           duration,
+		ServiceShutdownTime:    map[ServiceDescription]time.Duration{},
+		TimedOut:               []ServiceDescription{},
+		TransientInstances:     map[ServiceDescription]int{},
+		CrossScopeDependencies: []ServiceDependency{},
+		Conflicts:              []ShutdownConflict{},
+		Pending:                []ServiceDescription{},
+		RunnerErrors:           map[ServiceDescription]error{},
+	}
+
+	for _, desc := range services {
+		if _, ok := pending[desc]; ok {
+			report.Pending = append(report.Pending, desc)
+			report.Errors[desc] = ErrShutdownForced
+		} else {
+			report.Services = append(report.Services, desc)
+		}
+	}
+
+	return report
+}
+
+// trackShutdown records a service being shut down, until the returned function is called.
+func (s *RootScope) trackShutdown(desc ServiceDescription) func() {
+	s.inFlightShutdownsMu.Lock()
+	s.inFlightShutdowns[desc] = struct{}{}
+	s.inFlightShutdownsMu.Unlock()
+
+	return func() {
+		s.inFlightShutdownsMu.Lock()
+		delete(s.inFlightShutdowns, desc)
+		s.inFlightShutdownsMu.Unlock()
+	}
 }
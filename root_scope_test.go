@@ -2,6 +2,8 @@ package do
 
 import (
 	"context"
+	"os"
+	"syscall"
 	"testing"
 	"time"
 
@@ -292,3 +294,111 @@ func TestRootScope_ShutdownOnSignalsWithContext(t *testing.T) {
 	// would block until the application receives a termination signal from the OS or the context
 	// is canceled.
 }
+
+func TestRootScope_ShutdownOnSignalsWithOpts_graceTimeout(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 200*time.Millisecond)
+	is := assert.New(t)
+
+	injector := New()
+
+	stuck := &scopeTestStuckShutdowner{release: make(chan struct{})}
+	defer close(stuck.release)
+
+	ProvideNamedValue(injector, "stuck", stuck)
+	ProvideNamedValue(injector, "config", 42)
+	ProvideNamed(injector, "api", func(i Injector) (int, error) {
+		return MustInvokeNamed[int](i, "config"), nil
+	})
+	_ = MustInvokeNamed[int](injector, "api")
+
+	ch := make(chan os.Signal, 1)
+	ch <- syscall.SIGTERM
+
+	var forced *ShutdownReport
+	exitCode := 0
+
+	start := time.Now()
+	sig, report := injector.shutdownOnSignals(context.Background(), ShutdownOnSignalsOpts{
+		GraceTimeout:     30 * time.Millisecond,
+		OnForcedShutdown: func(_ os.Signal, r *ShutdownReport) { forced = r },
+		Exit:             func(code int) { exitCode = code },
+	}, ch, nil, func() {})
+
+	is.Equal(syscall.SIGTERM, sig)
+	is.GreaterOrEqual(time.Since(start), 30*time.Millisecond)
+	is.Equal(1, exitCode)
+	is.Same(forced, report)
+
+	// the services still registered, or being shut down, are pending
+	stuckDesc := newServiceDescription(injector.ID(), injector.Name(), "stuck")
+	configDesc := newServiceDescription(injector.ID(), injector.Name(), "config")
+	is.False(report.Succeed)
+	is.Equal([]ServiceDescription{configDesc, stuckDesc}, report.Pending)
+	is.Equal([]ServiceDescription{newServiceDescription(injector.ID(), injector.Name(), "api")}, report.Services)
+	is.ErrorIs(report.Errors[stuckDesc], ErrShutdownForced)
+	is.Contains(report.Error(), "[root] > stuck: DI: shutdown forced")
+}
+
+func TestRootScope_ShutdownOnSignalsWithOpts_secondSignal(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	injector := New()
+
+	stuck := &scopeTestStuckShutdowner{release: make(chan struct{})}
+	defer close(stuck.release)
+	ProvideNamedValue(injector, "stuck", stuck)
+
+	ch := make(chan os.Signal, 2)
+	ch <- os.Interrupt
+	ch <- os.Interrupt
+
+	exitCode := 0
+	sig, report := injector.shutdownOnSignals(context.Background(), ShutdownOnSignalsOpts{
+		ForceOnSecondSignal: true,
+		ExitCode:            130,
+		Exit:                func(code int) { exitCode = code },
+	}, ch, nil, func() {})
+
+	is.Equal(os.Interrupt, sig)
+	is.Equal(130, exitCode)
+	is.Equal([]ServiceDescription{newServiceDescription(injector.ID(), injector.Name(), "stuck")}, report.Pending)
+}
+
+func TestRootScope_ShutdownOnSignalsWithOpts_graceful(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	recorder := &drainTestRecorder{}
+	injector := New()
+	ProvideNamedValue(injector, "config", &reloadTestService{name: "config", recorder: recorder})
+
+	ch := make(chan os.Signal, 1)
+	reloadCh := make(chan os.Signal, 1)
+	reloadCh <- syscall.SIGHUP
+
+	reloads := 0
+	started := false
+	sig, report := injector.shutdownOnSignals(context.Background(), ShutdownOnSignalsOpts{
+		GraceTimeout:        time.Second,
+		ForceOnSecondSignal: true,
+		Exit:                func(code int) { is.Fail("unexpected exit") },
+		OnReload: func(sig os.Signal, r *ReloadReport) {
+			is.Equal(syscall.SIGHUP, sig)
+			is.True(r.Succeed)
+			reloads++
+			ch <- syscall.SIGTERM
+		},
+	}, ch, reloadCh, func() { started = true })
+
+	is.Equal(syscall.SIGTERM, sig)
+	is.Equal(1, reloads)
+	is.True(started)
+	is.Equal([]string{"reload config"}, recorder.list())
+	is.True(report.Succeed)
+	is.Empty(report.Pending)
+	is.Empty(injector.ListProvidedServices())
+}
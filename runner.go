@@ -0,0 +1,276 @@
+package do
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// RunnerState is the state of a service implementing Runner.
+type RunnerState string
+
+const (
+	// RunnerStateRunning means that the Run method is running.
+	RunnerStateRunning RunnerState = "running"
+	// RunnerStateBackoff means that the Run method returned, and is restarted after a backoff.
+	RunnerStateBackoff RunnerState = "backoff"
+	// RunnerStateExited means that the Run method returned nil, and is not restarted.
+	RunnerStateExited RunnerState = "exited"
+	// RunnerStateFailed means that the Run method failed, and is not restarted.
+	RunnerStateFailed RunnerState = "failed"
+	// RunnerStateStopped means that the Run method was stopped by a shutdown or a reset.
+	RunnerStateStopped RunnerState = "stopped"
+)
+
+// RunnerStatus describes a service implementing Runner, supervised by the container.
+type RunnerStatus struct {
+	Service   ServiceDescription
+	State     RunnerState
+	Policy    RestartPolicy
+	Restarts  int       // Number of restarts since the service was built
+	StartedAt time.Time // Start of the current, or last, run
+	LastError error     // Last error returned by Run, if any
+}
+
+// supervisedRunner runs the Run method of a service, and restarts it according to its restart
+// policy, until it is stopped.
+type supervisedRunner struct {
+	scope   *Scope
+	name    string
+	service any
+	runner  Runner
+	options serviceOptions
+
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	mu        sync.Mutex
+	state     RunnerState
+	restarts  int
+	startedAt time.Time
+	lastErr   error
+	stopErr   error
+}
+
+// superviseService starts the Run method of a freshly invoked service implementing Runner, unless
+// it is running already. Transient services, that have no single instance, and borrowed services
+// are not supervised.
+func (s *RootScope) superviseService(scope *Scope, name string, service any, instance any) {
+	runner, ok := instance.(Runner)
+	if !ok {
+		return
+	}
+
+	if _, ok := service.(serviceWrapperBuiltInstance); !ok {
+		return
+	}
+
+	options := getServiceOptions(service)
+	if !options.isShutdownEnabled() {
+		return
+	}
+
+	desc := newServiceDescription(scope.id, scope.name, name)
+
+	s.runnersMu.Lock()
+	if _, ok := s.runners[desc]; ok {
+		s.runnersMu.Unlock()
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &supervisedRunner{
+		scope:   scope,
+		name:    name,
+		service: service,
+		runner:  runner,
+		options: options,
+		cancel:  cancel,
+		done:    make(chan struct{}),
+		state:   RunnerStateRunning,
+	}
+	s.runners[desc] = r
+	s.runnersMu.Unlock()
+
+	go r.supervise(ctx)
+}
+
+// getRunner returns the supervised runner of a service, if any.
+func (s *RootScope) getRunner(desc ServiceDescription) (*supervisedRunner, bool) {
+	s.runnersMu.Lock()
+	defer s.runnersMu.Unlock()
+
+	r, ok := s.runners[desc]
+	return r, ok
+}
+
+// takeRunner removes the supervised runner of a service from the container, and returns it.
+func (s *RootScope) takeRunner(desc ServiceDescription) (*supervisedRunner, bool) {
+	s.runnersMu.Lock()
+	defer s.runnersMu.Unlock()
+
+	r, ok := s.runners[desc]
+	delete(s.runners, desc)
+	return r, ok
+}
+
+// Runners returns the state of the services implementing Runner, supervised by the container.
+// Runners are removed when their service is shut down or reset.
+func (s *RootScope) Runners() []RunnerStatus {
+	s.runnersMu.Lock()
+	runners := make([]*supervisedRunner, 0, len(s.runners))
+	for _, r := range s.runners {
+		runners = append(runners, r)
+	}
+	s.runnersMu.Unlock()
+
+	statuses := mAp(runners, func(r *supervisedRunner, _ int) RunnerStatus {
+		return r.status()
+	})
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return lessServiceDescription(statuses[i].Service, statuses[j].Service)
+	})
+
+	return statuses
+}
+
+func (r *supervisedRunner) status() RunnerStatus {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return RunnerStatus{
+		Service:   newServiceDescription(r.scope.id, r.scope.name, r.name),
+		State:     r.state,
+		Policy:    r.options.restartPolicy,
+		Restarts:  r.restarts,
+		StartedAt: r.startedAt,
+		LastError: r.lastErr,
+	}
+}
+
+// lastError returns the last error returned by Run.
+func (r *supervisedRunner) lastError() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.lastErr
+}
+
+// supervise runs Run until the context is canceled, or until the restart policy stops it.
+func (r *supervisedRunner) supervise(ctx context.Context) {
+	defer close(r.done)
+
+	opts := r.scope.RootScope().opts
+	backoff := r.options.restartBackoff
+
+	for {
+		r.mu.Lock()
+		r.state = RunnerStateRunning
+		r.startedAt = time.Now()
+		r.mu.Unlock()
+
+		start := time.Now()
+		err := runSafely(ctx, r.runner)
+		duration := time.Since(start)
+
+		if ctx.Err() != nil {
+			if errors.Is(err, context.Canceled) {
+				err = nil
+			}
+
+			r.mu.Lock()
+			r.state = RunnerStateStopped
+			r.stopErr = err
+			if err != nil {
+				r.lastErr = err
+			}
+			r.mu.Unlock()
+			return
+		}
+
+		restart := r.options.restartPolicy == RestartPolicyAlways ||
+			(r.options.restartPolicy == RestartPolicyOnFailure && err != nil)
+
+		r.mu.Lock()
+		if err != nil {
+			r.lastErr = err
+		}
+		switch {
+		case restart:
+			r.state = RunnerStateBackoff
+		case err != nil:
+			r.state = RunnerStateFailed
+		default:
+			r.state = RunnerStateExited
+		}
+		r.mu.Unlock()
+
+		// a clean exit is logged at the debug level
+		opts.logService(logLevelError, "DI: runner exited", r.scope, r.name, r.service, duration, err, LogKeyRestart, restart)
+
+		if !restart {
+			return
+		}
+
+		if duration > r.options.maxBackoff {
+			backoff = r.options.restartBackoff
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			r.mu.Lock()
+			r.state = RunnerStateStopped
+			r.mu.Unlock()
+			return
+		case <-timer.C:
+		}
+
+		backoff *= 2
+		if backoff > r.options.maxBackoff {
+			backoff = r.options.maxBackoff
+		}
+
+		r.mu.Lock()
+		r.restarts++
+		r.mu.Unlock()
+	}
+}
+
+// stop cancels the context of Run, and waits for it to return. It returns the error returned by
+// Run after the cancellation, if any.
+func (r *supervisedRunner) stop(ctx context.Context) error {
+	r.cancel()
+
+	select {
+	case <-r.done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.stopErr
+}
+
+// runSafely calls Run, and returns a panic as an error.
+func runSafely(ctx context.Context, runner Runner) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if e, ok := r.(error); ok {
+				err = fmt.Errorf("DI: runner panicked: %w", e)
+			} else {
+				err = fmt.Errorf("DI: runner panicked: %v", r)
+			}
+		}
+	}()
+
+	return runner.Run(ctx)
+}
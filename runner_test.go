@@ -0,0 +1,212 @@
+package do
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var _ Runner = (*runnerTestService)(nil)
+
+type runnerTestService struct {
+	runs     int32
+	failures int32 // number of runs failing before blocking
+	err      error // returned by a failing run
+	stopErr  error // returned when stopped
+	block    bool  // blocks until stopped, once the failures are over
+}
+
+func (s *runnerTestService) Run(ctx context.Context) error {
+	runs := atomic.AddInt32(&s.runs, 1)
+	if runs <= s.failures {
+		return s.err
+	}
+
+	if !s.block {
+		return nil
+	}
+
+	<-ctx.Done()
+	if s.stopErr != nil {
+		return s.stopErr
+	}
+	return ctx.Err()
+}
+
+func (s *runnerTestService) count() int {
+	return int(atomic.LoadInt32(&s.runs))
+}
+
+func runnerTestStatus(injector *RootScope, name string) RunnerStatus {
+	for _, status := range injector.Runners() {
+		if status.Service.Service == name {
+			return status
+		}
+	}
+	return RunnerStatus{}
+}
+
+func TestRootScope_Runners_policies(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 500*time.Millisecond)
+	is := assert.New(t)
+
+	injector := New()
+
+	never := &runnerTestService{failures: 1, err: assert.AnError}
+	onFailure := &runnerTestService{failures: 2, err: assert.AnError, block: true}
+	always := &runnerTestService{}
+
+	ProvideNamedValue(injector, "never", never)
+	ProvideNamed(injector, "on-failure", func(i Injector) (*runnerTestService, error) {
+		return onFailure, nil
+	}, WithRestartPolicy(RestartPolicyOnFailure), WithRestartBackoff(time.Millisecond, 4*time.Millisecond))
+	ProvideNamedValue(injector, "always", always, WithRestartPolicy(RestartPolicyAlways), WithRestartBackoff(time.Millisecond, time.Millisecond))
+	ProvideNamedValue(injector, "not-invoked", &runnerTestService{})
+
+	is.Empty(injector.Runners())
+
+	// services are supervised once built
+	_ = MustInvokeNamed[*runnerTestService](injector, "never")
+	_ = MustInvokeNamed[*runnerTestService](injector, "on-failure")
+	_ = MustInvokeNamed[*runnerTestService](injector, "on-failure")
+	_ = MustInvokeNamed[*runnerTestService](injector, "always")
+
+	is.Eventually(func() bool { return runnerTestStatus(injector, "never").State == RunnerStateFailed }, 100*time.Millisecond, time.Millisecond)
+	is.Eventually(func() bool { return onFailure.count() == 3 }, 100*time.Millisecond, time.Millisecond)
+	is.Eventually(func() bool { return always.count() >= 3 }, 100*time.Millisecond, time.Millisecond)
+
+	is.Len(injector.Runners(), 3)
+	is.Equal(1, never.count())
+
+	status := runnerTestStatus(injector, "never")
+	is.Equal(RestartPolicyNever, status.Policy)
+	is.Equal(0, status.Restarts)
+	is.ErrorIs(status.LastError, assert.AnError)
+
+	status = runnerTestStatus(injector, "on-failure")
+	is.Equal(RunnerStateRunning, status.State)
+	is.Equal(2, status.Restarts)
+
+	// the runners are stopped on shutdown, and their errors are reported
+	report := injector.Shutdown()
+	is.True(report.Succeed)
+	is.Empty(injector.Runners())
+	is.Equal(
+		map[ServiceDescription]error{
+			newServiceDescription(injector.ID(), injector.Name(), "never"):      assert.AnError,
+			newServiceDescription(injector.ID(), injector.Name(), "on-failure"): assert.AnError,
+		},
+		report.RunnerErrors,
+	)
+
+	runs := always.count()
+	time.Sleep(5 * time.Millisecond)
+	is.Equal(runs, always.count())
+}
+
+func TestRootScope_Runners_stopError(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	injector := New()
+	stopErr := errors.New("consumer did not ack")
+
+	ProvideNamedValue(injector, "consumer", &runnerTestService{block: true, stopErr: stopErr})
+	ProvideNamedValue(injector, "worker", &runnerTestService{block: true})
+	_ = MustInvokeNamed[*runnerTestService](injector, "consumer")
+	_ = MustInvokeNamed[*runnerTestService](injector, "worker")
+
+	consumer := newServiceDescription(injector.ID(), injector.Name(), "consumer")
+
+	report := injector.Shutdown()
+	is.False(report.Succeed)
+	is.Equal(map[ServiceDescription]error{consumer: stopErr}, report.Errors)
+	is.Equal(map[ServiceDescription]error{consumer: stopErr}, report.RunnerErrors)
+}
+
+func TestRootScope_Runners_reset(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	injector := New()
+	runner := &runnerTestService{block: true}
+
+	ProvideNamed(injector, "worker", func(i Injector) (*runnerTestService, error) {
+		return runner, nil
+	})
+	ProvideNamedTransient(injector, "job", func(i Injector) (*runnerTestService, error) {
+		return &runnerTestService{}, nil
+	})
+	ProvideNamedValue(injector, "borrowed", &runnerTestService{}, WithOwnership(OwnershipBorrowed))
+
+	_ = MustInvokeNamed[*runnerTestService](injector, "worker")
+	_ = MustInvokeNamed[*runnerTestService](injector, "job")
+	_ = MustInvokeNamed[*runnerTestService](injector, "borrowed")
+
+	// transient and borrowed services are not supervised
+	is.Len(injector.Runners(), 1)
+	is.Eventually(func() bool { return runner.count() == 1 }, 50*time.Millisecond, time.Millisecond)
+
+	// a reset stops the runner, and the next invocation starts it again
+	report := injector.Reset()
+	is.True(report.Succeed)
+	is.Empty(injector.Runners())
+
+	_ = MustInvokeNamed[*runnerTestService](injector, "worker")
+	is.Len(injector.Runners(), 1)
+	is.Eventually(func() bool { return runner.count() == 2 }, 50*time.Millisecond, time.Millisecond)
+
+	is.True(injector.Shutdown().Succeed)
+}
+
+func TestRootScope_Runners_zeroBackoff(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	logger := &testLogger{}
+	injector := NewWithOpts(&InjectorOpts{Logger: logger})
+	always := &runnerTestService{}
+
+	ProvideNamedValue(injector, "always", always, WithRestartPolicy(RestartPolicyAlways), WithRestartBackoff(0, 0))
+	_ = MustInvokeNamed[*runnerTestService](injector, "always")
+
+	// the run is not restarted before the default backoff
+	is.Eventually(func() bool { return always.count() == 1 }, 50*time.Millisecond, time.Millisecond)
+	time.Sleep(20 * time.Millisecond)
+	is.Equal(1, always.count())
+
+	// a clean exit is not an error
+	records := logger.find("DI: runner exited", "always")
+	is.Len(records, 1)
+	is.Equal("debug", records[0].level)
+
+	is.True(injector.Shutdown().Succeed)
+}
+
+func TestRunSafely(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	err := runSafely(context.Background(), runnerFunc(func(ctx context.Context) error {
+		panic(assert.AnError)
+	}))
+	is.ErrorIs(err, assert.AnError)
+	is.Contains(err.Error(), "DI: runner panicked")
+
+	err = runSafely(context.Background(), runnerFunc(func(ctx context.Context) error {
+		panic("boom")
+	}))
+	is.EqualError(err, "DI: runner panicked: boom")
+}
+
+type runnerFunc func(context.Context) error
+
+func (f runnerFunc) Run(ctx context.Context) error { return f(ctx) }
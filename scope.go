@@ -305,7 +305,85 @@ func (s *Scope) HealthCheck() map[string]error {
 //
 // Play: https://go.dev/play/p/pJcJGOF5zeK
 func (s *Scope) HealthCheckWithContext(ctx context.Context) map[string]error {
-	s.logf("requested health check")
+	return s.checkWithContext(ctx, HealthCheckKindHealth)
+}
+
+// ReadinessCheck performs readiness checks on all services in the current scope and its ancestors
+// that implement the ReadinessChecker interface, or the Healthchecker interface as a fallback.
+//
+// Returns a map of service names to error values. A nil error indicates that the service is ready.
+func (s *Scope) ReadinessCheck() map[string]error {
+	return s.ReadinessCheckWithContext(context.Background())
+}
+
+// ReadinessCheckWithContext performs readiness checks on all services in the current scope and its
+// ancestors, with context support for cancellation and timeouts. The HealthCheckTimeout,
+// HealthCheckGlobalTimeout and HealthCheckParallelism options apply.
+//
+// Returns a map of service names to error values. A nil error indicates that the service is ready.
+func (s *Scope) ReadinessCheckWithContext(ctx context.Context) map[string]error {
+	return s.checkWithContext(ctx, HealthCheckKindReadiness)
+}
+
+// LivenessCheck performs liveness checks on all services in the current scope and its ancestors
+// that implement the LivenessChecker interface, or the Healthchecker interface as a fallback.
+//
+// Returns a map of service names to error values. A nil error indicates that the service is alive.
+func (s *Scope) LivenessCheck() map[string]error {
+	return s.LivenessCheckWithContext(context.Background())
+}
+
+// LivenessCheckWithContext performs liveness checks on all services in the current scope and its
+// ancestors, with context support for cancellation and timeouts. The HealthCheckTimeout,
+// HealthCheckGlobalTimeout and HealthCheckParallelism options apply.
+//
+// Returns a map of service names to error values. A nil error indicates that the service is alive.
+func (s *Scope) LivenessCheckWithContext(ctx context.Context) map[string]error {
+	return s.checkWithContext(ctx, HealthCheckKindLiveness)
+}
+
+// HealthCheckTree performs health checks on all services in the current scope, its ancestors and
+// its descendants. See HealthCheckTreeWithContext.
+func (s *Scope) HealthCheckTree() HealthCheckTreeOutput {
+	return s.HealthCheckTreeWithContext(context.Background())
+}
+
+// HealthCheckTreeWithContext performs health checks on all services in the current scope, its
+// ancestors and its descendants, with context support for cancellation and timeouts.
+//
+// Unlike HealthCheck, services living in child scopes are checked as well, and every service
+// is reported, including services overridden in a descendant scope. The results are grouped
+// in a tree that mirrors ExplainInjector, and can be flattened with Results(), keyed by
+// ServiceDescription.
+//
+// The HealthCheckTimeout, HealthCheckGlobalTimeout and HealthCheckParallelism options apply.
+func (s *Scope) HealthCheckTreeWithContext(ctx context.Context) HealthCheckTreeOutput {
+	s.logf("requested health check of the scope tree")
+	return s.checkTree(ctx, HealthCheckKindHealth)
+}
+
+// HealthReport checks the services in the current scope and its ancestors, and returns a
+// structured report. See HealthReportWithContext.
+func (s *Scope) HealthReport(opts HealthReportOpts) *HealthReport {
+	return s.HealthReportWithContext(context.Background(), opts)
+}
+
+// HealthReportWithContext checks the services in the current scope and its ancestors, with
+// context support for cancellation and timeouts, and returns a structured report.
+//
+// Unlike HealthCheck, the report holds the status, latency and details of each service.
+// A failing service is unhealthy, or degraded when registered with WithCriticality(CriticalityNonCritical).
+// The services depending on a failed service, according to the DAG, are marked as affected,
+// and are skipped when opts.SkipAffected is true.
+//
+// The HealthCheckTimeout, HealthCheckGlobalTimeout and HealthCheckParallelism options apply.
+func (s *Scope) HealthReportWithContext(ctx context.Context, opts HealthReportOpts) *HealthReport {
+	s.logf("requested health report")
+	return buildHealthReport(ctx, s, opts)
+}
+
+func (s *Scope) checkWithContext(ctx context.Context, kind HealthCheckKind) map[string]error {
+	s.logf("requested %s check", kind)
 
 	if s.rootScope.opts.HealthCheckGlobalTimeout > 0 {
 		var cancel context.CancelFunc
@@ -315,28 +393,28 @@ func (s *Scope) HealthCheckWithContext(ctx context.Context) map[string]error {
 
 	results := map[string]error{}
 
-	asyncResults := s.asyncHealthCheckWithContext(ctx)
+	asyncResults := s.asyncCheckWithContext(ctx, kind)
 	for name, err := range asyncResults {
 		results[name] = <-err
 	}
 
-	s.logf("got health check results: %v", results)
+	s.logf("got %s check results: %v", kind, results)
 
 	return results
 }
 
-func (s *Scope) asyncHealthCheckWithContext(ctx context.Context) map[string]<-chan error {
+func (s *Scope) asyncCheckWithContext(ctx context.Context, kind HealthCheckKind) map[string]<-chan error {
 	asyncResults := map[string]<-chan error{}
 
 	s.mu.RLock()
 	for name := range s.services {
-		asyncResults[name] = s.rootScope.queueServiceHealthcheck(ctx, s, name)
+		asyncResults[name] = s.rootScope.queueServiceCheck(ctx, s, name, kind)
 	}
 	s.mu.RUnlock()
 
 	// @TODO: We should not check the status of services that are not inherited (overridden in a child tree)
 	for _, ancestor := range s.Ancestors() {
-		health := ancestor.asyncHealthCheckWithContext(ctx)
+		health := ancestor.asyncCheckWithContext(ctx, kind)
 		for name, err := range health {
 			if _, ok := asyncResults[name]; !ok {
 				asyncResults[name] = err
@@ -360,6 +438,10 @@ func (s *Scope) Shutdown() *ShutdownReport {
 // ShutdownWithContext gracefully shuts down the scope and all its children with context support.
 // This method performs shutdown operations in parallel for better performance.
 //
+// Services of the scope and of its descendants are shut down in a single dependency order, computed
+// from the DAG: a service is shut down after the services depending on it, even across scopes.
+// Services of children scopes are shut down first, unless a dependency requires otherwise.
+//
 // Parameters:
 //   - ctx: Context for cancellation and timeout control
 //
@@ -370,26 +452,81 @@ func (s *Scope) ShutdownWithContext(ctx context.Context) *ShutdownReport {
 	s.logf("requested shutdown")
 	start := time.Now()
 
-	rep1 := s.shutdownChildrenInParallel(ctx)
-	rep2 := s.shutdownServicesInParallel(ctx)
+	report := s.shutdownServicesInParallel(ctx)
+	s.removeChildScopes()
 	s.logf("shut down services")
 
-	report := mergeShutdownReports(rep1, rep2)
 	report.ShutdownTime = time.Since(start)
 	report.Succeed = len(report.Errors) == 0
+
+	s.RootScope().opts.logScope(logLevelDebug, "DI: scope shut down", s, LogKeyDuration, report.ShutdownTime)
+	return report
+}
+
+// ShutdownPlan returns the order in which the scope and its children would be shut down, without
+// shutting them down. Like ShutdownWithContext, services are shut down by waves of services having
+// no dependent, across scopes.
+//
+// Waves flagged as Cycle are circular dependencies: their services would be shut down together,
+// without taking care of order.
+func (s *Scope) ShutdownPlan() ShutdownPlanOutput {
+	return newShutdownPlanOutput(s, s.shutdownOrder())
+}
+
+// Reset returns the services of the scope and its children to their registered but unbuilt state.
+// This method calls ResetWithContext with a background context.
+//
+// Returns a ShutdownReport containing the reset services, and any errors and timings.
+func (s *Scope) Reset() *ShutdownReport {
+	return s.ResetWithContext(context.Background())
+}
+
+// ResetWithContext returns the services of the scope and its children to their registered but
+// unbuilt state. Built services are shut down in the shutdown order, like ShutdownWithContext, but
+// registrations and children scopes are kept: lazy services are built again on the next invocation,
+// and the tracked instances of transient services are released.
+//
+// Eager services are kept as is, since their value cannot be built again. Borrowed services are
+// kept too.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout control
+//
+// Returns a ShutdownReport containing the reset services, and any errors and timings.
+func (s *Scope) ResetWithContext(ctx context.Context) *ShutdownReport {
+	s.logf("requested reset")
+	start := time.Now()
+
+	report := s.resetServicesInParallel(ctx)
+	s.logf("reset services")
+
+	report.ShutdownTime = time.Since(start)
+	report.Succeed = len(report.Errors) == 0
+
+	s.RootScope().opts.logScope(logLevelDebug, "DI: scope reset", s, LogKeyDuration, report.ShutdownTime)
 	return report
 }
 
-// shutdownChildrenInParallel runs a parallel shutdown of children scopes.
-// This method shuts down all child scopes concurrently and then removes them
-// from the scope hierarchy.
+// Restart resets the scope and its children, then builds again the lazy services that were built.
+// This method calls RestartWithContext with a background context.
+func (s *Scope) Restart() (*ShutdownReport, error) {
+	return s.RestartWithContext(context.Background())
+}
+
+// RestartWithContext resets the scope and its children (see ResetWithContext), then invokes again
+// the lazy services that were built, dependencies first.
 //
 // Parameters:
 //   - ctx: Context for cancellation and timeout control
 //
-// Returns a ShutdownReport containing any errors from child scope shutdowns.
-func (s *Scope) shutdownChildrenInParallel(ctx context.Context) *ShutdownReport {
-	// Snapshot children under lock
+// Returns the reset report, and the first error returned while building the services again.
+func (s *Scope) RestartWithContext(ctx context.Context) (*ShutdownReport, error) {
+	report := s.ResetWithContext(ctx)
+	return report, s.rebuildServices(report)
+}
+
+// removeChildScopes removes the children scopes, and their descendants, from the scope tree.
+func (s *Scope) removeChildScopes() {
 	s.mu.Lock()
 	children := make([]*Scope, 0, len(s.childScopes))
 	for _, c := range s.childScopes {
@@ -398,23 +535,12 @@ func (s *Scope) shutdownChildrenInParallel(ctx context.Context) *ShutdownReport
 	s.childScopes = make(map[string]*Scope) // scopes are removed from DI container
 	s.mu.Unlock()
 
-	reports := make([]*ShutdownReport, len(children))
-
-	var wg sync.WaitGroup
-	for index, child := range children {
-		wg.Add(1)
-
-		go func(c *Scope, i int) {
-			reports[i] = c.ShutdownWithContext(ctx)
-			wg.Done()
-		}(child, index)
+	for _, child := range children {
+		child.removeChildScopes()
 	}
-	wg.Wait()
-
-	return mergeShutdownReports(reports...)
 }
 
-// shutdownServicesInParallel runs a parallel shutdown of scope services.
+// shutdownServicesInParallel runs a parallel shutdown of the services of the scope and its descendants.
 // This method implements a dependency-aware shutdown algorithm that shuts down
 // services in the correct order to avoid dependency issues.
 //
@@ -427,38 +553,34 @@ func (s *Scope) shutdownChildrenInParallel(ctx context.Context) *ShutdownReport
 // Returns a ShutdownReport containing any errors from service shutdowns.
 func (s *Scope) shutdownServicesInParallel(ctx context.Context) *ShutdownReport {
 	report := &ShutdownReport{
-		Succeed:             true,
-		Services:            []ServiceDescription{},
-		Errors:              map[ServiceDescription]error{},
-		ShutdownTime:        0,
-		ServiceShutdownTime: map[ServiceDescription]time.Duration{},
-	}
-
-	listServices := func() []string {
-		s.mu.RLock()
-		defer s.mu.RUnlock()
-		return keys(s.services)
+		Succeed:                true,
+		Services:               []ServiceDescription{},
+		Errors:                 map[ServiceDescription]error{},
+		ShutdownTime:           0,
+		ServiceShutdownTime:    map[ServiceDescription]time.Duration{},
+		TimedOut:               []ServiceDescription{},
+		TransientInstances:     map[ServiceDescription]int{},
+		CrossScopeDependencies: []ServiceDependency{},
+		Conflicts:              []ShutdownConflict{},
+		Pending:                []ServiceDescription{},
+		RunnerErrors:           map[ServiceDescription]error{},
 	}
 
 	for {
-		// loop over the services that have not been shutdown already
-		services := listServices()
-		if len(services) == 0 {
+		// Services registered during the shutdown are shut down in the next iteration.
+		order := s.shutdownOrder()
+		if len(order.waves) == 0 {
 			break
 		}
 
-		// Check, under a single DAG lock, which services have no dependents
-		// (dependencies allowed here). Services having dependents must be
-		// shutdown first; the next iteration will shutdown the current service.
-		servicesToShutdown := s.rootScope.dag.listServicesHavingNoDependent(s.id, s.name, services)
+		report.CrossScopeDependencies = append(report.CrossScopeDependencies, order.crossScopeDependencies...)
+		report.Conflicts = append(report.Conflicts, order.conflicts...)
+		for _, conflict := range order.conflicts {
+			s.logf("shutdown constraints of service %s in scope %s are not honored", conflict.Service.Service, conflict.Service.ScopeName)
+		}
 
-		if len(servicesToShutdown) > 0 {
-			r := s.shutdownServicesWithoutDependenciesInParallel(ctx, servicesToShutdown)
-			report = mergeShutdownReports(report, r)
-		} else {
-			// In this branch, we expect that there is a circular dependency. We shutdown all services, without taking care of order.
-			// This is a fallback mechanism to ensure all services are eventually shut down.
-			r := s.shutdownServicesWithoutDependenciesInParallel(ctx, services)
+		for _, wave := range order.waves {
+			r := s.rootScope.shutdownServicesWithoutDependenciesInParallel(ctx, order.scopes, wave.services)
 			report = mergeShutdownReports(report, r)
 		}
 	}
@@ -466,55 +588,228 @@ func (s *Scope) shutdownServicesInParallel(ctx context.Context) *ShutdownReport
 	return report
 }
 
+// shutdownWave is a group of services having no dependent, shut down in parallel.
+type shutdownWave struct {
+	services []ServiceDescription
+	cycle    bool
+}
+
+type shutdownOrder struct {
+	scopes                 map[string]*Scope
+	waves                  []shutdownWave
+	crossScopeDependencies []ServiceDependency
+	conflicts              []ShutdownConflict
+}
+
+// shutdownOrder computes the shutdown waves of the services of the scope and its descendants.
+//
+// A service is ready when no pending service depends on it, according to the DAG, and when its
+// ordering constraints are met: see shutdownConstraints. Among the ready services, the services of
+// a scope wait for the services of its descendants (child-first rule).
+// When the child-first rule blocks every ready service, the services depending on a pending service
+// of a descendant scope are shut down first. When no service is ready, we expect a circular
+// dependency: the remaining services are shut down together, without taking care of order.
+func (s *Scope) shutdownOrder() shutdownOrder {
+	order := shutdownOrder{
+		scopes:                 map[string]*Scope{},
+		waves:                  []shutdownWave{},
+		crossScopeDependencies: []ServiceDependency{},
+		conflicts:              []ShutdownConflict{},
+	}
+
+	pending := map[ServiceDescription]struct{}{}
+	ancestors := map[string][]string{} // ancestors of each scope, within the shut down tree
+
+	var walk func(scope *Scope, chain []string)
+	walk = func(scope *Scope, chain []string) {
+		order.scopes[scope.id] = scope
+		ancestors[scope.id] = chain
+
+		scope.mu.RLock()
+		for name := range scope.services {
+			pending[newServiceDescription(scope.id, scope.name, name)] = struct{}{}
+		}
+		scope.mu.RUnlock()
+
+		for _, child := range scope.Children() {
+			walk(child, append(append([]string{}, chain...), scope.id))
+		}
+	}
+	walk(s, []string{})
+
+	dag := s.rootScope.dag
+
+	for desc := range pending {
+		_, dependents := dag.explainService(desc.ScopeID, desc.ScopeName, desc.Service)
+		for _, dependent := range dependents {
+			if _, ok := pending[dependent]; ok && dependent.ScopeID != desc.ScopeID {
+				order.crossScopeDependencies = append(order.crossScopeDependencies, ServiceDependency{Dependent: dependent, Dependency: desc})
+			}
+		}
+	}
+	sortServiceDependencies(order.crossScopeDependencies)
+
+	constraints := newShutdownConstraints(order.scopes, pending, ancestors)
+
+	// number of pending services in the descendants of each scope
+	pendingInDescendants := map[string]int{}
+	for desc := range pending {
+		for _, ancestor := range ancestors[desc.ScopeID] {
+			pendingInDescendants[ancestor]++
+		}
+	}
+
+	for len(pending) > 0 {
+		ready, conflicts := constraints.ready(dag.listServicesHavingNoDependent(pending), pending)
+		order.conflicts = append(order.conflicts, conflicts...)
+
+		wave := filter(ready, func(desc ServiceDescription, _ int) bool {
+			return pendingInDescendants[desc.ScopeID] == 0
+		})
+		if len(wave) == 0 {
+			wave = filter(ready, func(desc ServiceDescription, _ int) bool {
+				return dependsOnPendingDescendant(dag, constraints, desc, pending, ancestors)
+			})
+		}
+		if len(wave) == 0 {
+			wave = ready
+		}
+
+		cycle := len(wave) == 0
+		if cycle {
+			wave = keys(pending)
+		}
+
+		for _, desc := range wave {
+			delete(pending, desc)
+			for _, ancestor := range ancestors[desc.ScopeID] {
+				pendingInDescendants[ancestor]--
+			}
+		}
+
+		sortServiceDescriptions(wave)
+		order.waves = append(order.waves, shutdownWave{services: wave, cycle: cycle})
+	}
+
+	return order
+}
+
+// dependsOnPendingDescendant returns true when the service depends, directly or through other
+// pending services, on a pending service of a descendant scope. A service that must be shut down
+// before another one, according to the ordering constraints, is considered as depending on it.
+func dependsOnPendingDescendant(dag *DAG, constraints shutdownConstraints, desc ServiceDescription, pending map[ServiceDescription]struct{}, ancestors map[string][]string) bool {
+	visited := map[ServiceDescription]struct{}{desc: {}}
+	queue := []ServiceDescription{desc}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		dependencies, _ := dag.explainService(current.ScopeID, current.ScopeName, current.Service)
+		dependencies = append(dependencies, constraints.before[current]...)
+		for _, dependency := range dependencies {
+			if _, ok := pending[dependency]; !ok {
+				continue
+			}
+			if _, ok := visited[dependency]; ok {
+				continue
+			}
+			visited[dependency] = struct{}{}
+
+			for _, ancestor := range ancestors[dependency.ScopeID] {
+				if ancestor == desc.ScopeID {
+					return true
+				}
+			}
+
+			queue = append(queue, dependency)
+		}
+	}
+
+	return false
+}
+
 // shutdownServicesWithoutDependenciesInParallel shuts down multiple services concurrently
 // without considering dependency order. This method is used when services have no dependents
 // or when handling circular dependencies.
 //
+// Services are queued in a deterministic order, through the shutdown pool when ShutdownParallelism
+// is set. Services of unknown scopes are ignored.
+//
 // Parameters:
 //   - ctx: Context for cancellation and timeout control
-//   - serviceNames: List of service names to shut down
+//   - scopes: The scopes of the services, keyed by ID
+//   - services: List of services to shut down
 //
 // Returns a ShutdownReport containing any errors from the shutdown operations.
-func (s *Scope) shutdownServicesWithoutDependenciesInParallel(ctx context.Context, serviceNames []string) *ShutdownReport {
-	if len(serviceNames) == 0 {
-		return &ShutdownReport{Succeed: true, Services: []ServiceDescription{}, Errors: map[ServiceDescription]error{}, ShutdownTime: 0, ServiceShutdownTime: map[ServiceDescription]time.Duration{}}
-	}
+func (s *RootScope) shutdownServicesWithoutDependenciesInParallel(ctx context.Context, scopes map[string]*Scope, services []ServiceDescription) *ShutdownReport {
+	return s.stopServicesInParallel(ctx, scopes, services, (*Scope).serviceShutdown)
+}
 
-	mu := sync.Mutex{}
-	services := []ServiceDescription{}
-	errors := map[ServiceDescription]error{}
-	perServiceTimes := map[ServiceDescription]time.Duration{}
+// stopServicesInParallel runs stop on multiple services concurrently, like
+// shutdownServicesWithoutDependenciesInParallel, and reports the results.
+func (s *RootScope) stopServicesInParallel(ctx context.Context, scopes map[string]*Scope, services []ServiceDescription, stop func(*Scope, context.Context, string) error) *ShutdownReport {
+	services = filter(services, func(desc ServiceDescription, _ int) bool {
+		_, ok := scopes[desc.ScopeID]
+		return ok
+	})
+	sortServiceDescriptions(services)
+
+	durations := make([]time.Duration, len(services))
+	transientInstances := make([]int, len(services))
+	tracked := make([]bool, len(services))
+	runners := make([]*supervisedRunner, len(services))
+	results := s.runShutdownJobs(len(services), func(i int) error {
+		scope := scopes[services[i].ScopeID]
+		if serviceAny, ok := scope.serviceGet(services[i].Service); ok {
+			if svc, ok := serviceAny.(serviceWrapperTrackedInstances); ok {
+				transientInstances[i], tracked[i] = svc.countTrackedInstances()
+			}
+		}
+		runners[i], _ = s.getRunner(services[i])
 
-	var wg sync.WaitGroup
-	wg.Add(len(serviceNames))
+		start := time.Now()
+		err := stop(scope, ctx, services[i].Service)
+		durations[i] = time.Since(start)
+		return err
+	})
 
-	for _, name := range serviceNames {
-		go func(n string) {
-			desc := newServiceDescription(s.id, s.name, n)
-			start := time.Now()
-			e := s.serviceShutdown(ctx, n)
-			dt := time.Since(start)
+	errors := map[ServiceDescription]error{}
+	perServiceTimes := map[ServiceDescription]time.Duration{}
+	timedOut := []ServiceDescription{}
+	perServiceInstances := map[ServiceDescription]int{}
+	runnerErrors := map[ServiceDescription]error{}
 
-			mu.Lock()
-			services = append(services, desc)
-			if e != nil {
-				errors[desc] = e
+	for i, desc := range services {
+		if results[i] != nil {
+			errors[desc] = results[i]
+		}
+		if isShutdownTimeout(results[i]) {
+			timedOut = append(timedOut, desc)
+		}
+		perServiceTimes[desc] = durations[i]
+		if tracked[i] {
+			perServiceInstances[desc] = transientInstances[i]
+		}
+		if runners[i] != nil {
+			if err := runners[i].lastError(); err != nil {
+				runnerErrors[desc] = err
 			}
-			perServiceTimes[desc] = dt
-			mu.Unlock()
-
-			wg.Done()
-		}(name)
+		}
 	}
 
-	wg.Wait()
-
 	return &ShutdownReport{
-		Succeed:             len(errors) == 0,
-		Services:            services,
-		Errors:              errors,
-		ShutdownTime:        0,
-		ServiceShutdownTime: perServiceTimes,
+		Succeed:                len(errors) == 0,
+		Services:               services,
+		Errors:                 errors,
+		ShutdownTime:           0,
+		ServiceShutdownTime:    perServiceTimes,
+		TimedOut:               timedOut,
+		TransientInstances:     perServiceInstances,
+		CrossScopeDependencies: []ServiceDependency{},
+		Conflicts:              []ShutdownConflict{},
+		Pending:                []ServiceDescription{},
+		RunnerErrors:           runnerErrors,
 	}
 }
 
@@ -549,6 +844,8 @@ func (s *Scope) clone(root *RootScope, parent *Scope) *Scope {
 		}
 
 		s.rootScope.opts.onAfterRegistration(clone, name)
+		s.rootScope.opts.onRegistrationEvent(clone, name, clone.services[name])
+		s.rootScope.opts.logService(logLevelError, "DI: service registered", clone, name, clone.services[name], 0, nil)
 	}
 
 	for name, index := range childScopes {
@@ -657,6 +954,8 @@ func (s *Scope) serviceSet(name string, service any) {
 	s.mu.Unlock()
 
 	s.RootScope().opts.onAfterRegistration(s, name)
+	s.RootScope().opts.onRegistrationEvent(s, name, service)
+	s.RootScope().opts.logService(logLevelError, "DI: service registered", s, name, service, 0, nil)
 }
 
 // serviceForEach iterates over all services in the current scope and calls the provided callback
@@ -733,6 +1032,19 @@ func (s *Scope) serviceForEachRec(cb func(name string, scope *Scope, service any
 // Returns an error if the health check fails, or nil if the service is healthy
 // or doesn't implement the Healthchecker interface.
 func (s *Scope) serviceHealthCheck(ctx context.Context, name string) error {
+	return s.serviceCheck(ctx, name, HealthCheckKindHealth)
+}
+
+// serviceCheck performs a check of the given kind on a specific service in the current scope.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout control
+//   - name: The name of the service to check
+//   - kind: The kind of check (health, readiness or liveness)
+//
+// Returns an error if the check fails, or nil if the check succeeded or the service
+// doesn't implement any check of this kind.
+func (s *Scope) serviceCheck(ctx context.Context, name string, kind HealthCheckKind) error {
 	s.mu.RLock()
 
 	serviceAny, ok := s.services[name]
@@ -743,16 +1055,34 @@ func (s *Scope) serviceHealthCheck(ctx context.Context, name string) error {
 
 	s.mu.RUnlock()
 
-	service, ok := serviceAny.(serviceWrapperHealthcheck)
+	if !getServiceOptions(serviceAny).isCheckEnabled() {
+		return nil
+	}
+
+	service, ok := serviceAny.(serviceWrapperCheck)
 	if ok {
-		s.logf("requested health check for service %s", name)
+		s.logf("requested %s check for service %s", kind, name)
 
 		// A timeout error is not triggered when the service is not a healthchecker.
 		// If the healthchecker does not support context.Timeout, the error will be triggered by raceWithTimeout().
-		return raceWithTimeout(
+		start := time.Now()
+		ctx, span := s.RootScope().opts.startSpan(ctx, spanNameOfCheck(kind), s, name, serviceAny, nil)
+		err := raceWithTimeout(
 			ctx,
-			service.healthcheck,
+			func(ctx context.Context) error {
+				return service.check(ctx, kind)
+			},
 		)
+		span.End(err)
+		duration := time.Since(start)
+
+		s.RootScope().opts.logService(logLevelWarn, "DI: service health checked", s, name, serviceAny, duration, err, "check", string(kind))
+
+		if !s.RootScope().opts.healthCheckEventHooks.isEmpty() {
+			s.RootScope().opts.onHealthCheckEvent(newHealthCheckEvent(s, name, serviceAny, kind, duration, err))
+		}
+
+		return err
 	}
 
 	// Should never happen.
@@ -783,20 +1113,67 @@ func (s *Scope) serviceShutdown(ctx context.Context, name string) error {
 		return serviceNotFound(s, ErrServiceNotFound, []string{name})
 	}
 
-	var err error
+	defer s.rootScope.trackShutdown(newServiceDescription(s.id, s.name, name))()
 
 	service, ok := serviceAny.(serviceWrapperShutdown)
-	if ok {
-		s.logf("requested shutdown for service %s", name)
-
-		s.RootScope().opts.onBeforeShutdown(s, name)
-		err = service.shutdown(ctx)
-		s.RootScope().opts.onAfterShutdown(s, name, err)
-	} else {
+	if !ok {
 		// Should never happen.
 		panic(fmt.Errorf("DI: service `%s` is not shutdowner", name))
 	}
 
+	s.logf("requested shutdown for service %s", name)
+
+	shutdown := service.shutdown
+	if !getServiceOptions(serviceAny).isShutdownEnabled() {
+		// A borrowed service is removed from the container, but its owner shuts it down.
+		shutdown = func(context.Context) error { return nil }
+	}
+
+	return s.runServiceShutdown(ctx, name, serviceAny, shutdown)
+}
+
+// runServiceShutdown runs the shutdown function of a service, within its timeout, and reports it to
+// the hooks, tracing and logs.
+func (s *Scope) runServiceShutdown(ctx context.Context, name string, serviceAny any, shutdown func(context.Context) error) error {
+	var err error
+
+	timeout := s.RootScope().opts.ShutdownTimeout
+	if t := getServiceOptions(serviceAny).shutdownTimeout; t > 0 {
+		timeout = t
+	}
+
+	if runner, ok := s.rootScope.takeRunner(newServiceDescription(s.id, s.name, name)); ok {
+		// Run is stopped before the instance is shut down.
+		shutdownInstance := shutdown
+		shutdown = func(ctx context.Context) error {
+			err := runner.stop(ctx)
+			if e := shutdownInstance(ctx); err == nil {
+				err = e
+			}
+			return err
+		}
+	}
+
+	s.RootScope().opts.onBeforeShutdown(s, name)
+	start := time.Now()
+	ctx, span := s.RootScope().opts.startSpan(ctx, SpanNameShutdown, s, name, serviceAny, nil)
+	if timeout > 0 {
+		// A service overrunning its timeout is abandoned.
+		timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
+		err = raceWithContext(timeoutCtx, shutdown, ErrShutdownTimeout)
+		cancel()
+	} else {
+		err = shutdown(ctx)
+	}
+	span.End(err)
+	duration := time.Since(start)
+	s.RootScope().opts.onAfterShutdown(s, name, err)
+	s.RootScope().opts.logService(logLevelError, "DI: service shut down", s, name, serviceAny, duration, err)
+
+	if !s.RootScope().opts.shutdownEventHooks.isEmpty() {
+		s.RootScope().opts.onShutdownEvent(newShutdownEvent(s, name, serviceAny, duration, err))
+	}
+
 	return err
 }
 
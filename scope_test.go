@@ -460,6 +460,31 @@ func TestScope_HealthCheck(t *testing.T) {
 	is.Equal(map[string]error{"non-healthcheckable-a": nil, "root-a": assert.AnError}, nonHealthcheckableScope.HealthCheck()) // Includes ancestor services
 }
 
+func TestScope_ReadinessAndLivenessCheck(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	rootScope := New()
+	child := rootScope.Scope("child")
+
+	rootScope.serviceSet("root-a", newServiceLazy("root-a", func(i Injector) (*lazyTestHeathcheckerKO, error) {
+		return &lazyTestHeathcheckerKO{foobar: "foobar"}, nil
+	}))
+	child.serviceSet("child-a", newServiceLazy("child-a", func(i Injector) (*lifecycleTestProbes, error) {
+		return &lifecycleTestProbes{}, nil
+	}))
+
+	_, _ = invokeByName[*lazyTestHeathcheckerKO](rootScope, "root-a")
+	_, _ = invokeByName[*lifecycleTestProbes](child, "child-a")
+
+	is.Equal(map[string]error{"child-a": assert.AnError, "root-a": assert.AnError}, child.ReadinessCheck())
+	is.Equal(map[string]error{"child-a": nil, "root-a": assert.AnError}, child.LivenessCheck())
+	is.Equal(map[string]error{"child-a": nil, "root-a": assert.AnError}, child.HealthCheck())
+	is.Equal(map[string]error{"root-a": assert.AnError}, rootScope.ReadinessCheckWithContext(context.Background()))
+	is.Equal(map[string]error{"root-a": assert.AnError}, rootScope.LivenessCheckWithContext(context.Background()))
+}
+
 func TestScope_HealthCheckWithContext(t *testing.T) {
 	t.Parallel()
 	is := assert.New(t)
@@ -1982,3 +2007,242 @@ func TestScope_ContextExpiration_ParallelOperations(t *testing.T) {
 		is.Equal(1, service.getShutdownCount())
 	}
 }
+
+var _ Shutdowner = (*scopeTestStuckShutdowner)(nil)
+
+// scopeTestStuckShutdowner ignores the shutdown context, and blocks until released.
+type scopeTestStuckShutdowner struct {
+	release chan struct{}
+}
+
+func (s *scopeTestStuckShutdowner) Shutdown() {
+	<-s.release
+}
+
+func TestScope_ShutdownTimeout(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 200*time.Millisecond)
+	is := assert.New(t)
+
+	injector := New()
+
+	stuck := &scopeTestStuckShutdowner{release: make(chan struct{})}
+	defer close(stuck.release)
+
+	ProvideNamedValue(injector, "stuck", stuck, WithShutdownTimeout(20*time.Millisecond))
+	ProvideNamed(injector, "dependent", func(i Injector) (*lazyTestShutdownerOK, error) {
+		_ = MustInvokeNamed[*scopeTestStuckShutdowner](i, "stuck")
+		return &lazyTestShutdownerOK{}, nil
+	})
+	ProvideNamedValue(injector, "fast", newScopeTestSlowShutdowner(time.Millisecond))
+	_ = MustInvokeNamed[*lazyTestShutdownerOK](injector, "dependent")
+
+	start := time.Now()
+	report := injector.Shutdown()
+	duration := time.Since(start)
+
+	// the stuck service is abandoned, the rest of the DAG shuts down on time
+	is.Less(duration, 100*time.Millisecond)
+	is.False(report.Succeed)
+	is.Len(report.Services, 3)
+	is.Len(report.Errors, 1)
+
+	desc := newServiceDescription(injector.ID(), injector.Name(), "stuck")
+	is.Equal([]ServiceDescription{desc}, report.TimedOut)
+	is.ErrorIs(report.Errors[desc], ErrShutdownTimeout)
+	is.Contains(report.Errors[desc].Error(), context.DeadlineExceeded.Error())
+	is.Empty(injector.ListProvidedServices())
+}
+
+func TestScope_ShutdownTimeout_reset(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 200*time.Millisecond)
+	is := assert.New(t)
+
+	injector := New()
+
+	stuck := &scopeTestStuckShutdowner{release: make(chan struct{})}
+	defer close(stuck.release)
+
+	builds := 0
+	ProvideNamed(injector, "stuck", func(i Injector) (*scopeTestStuckShutdowner, error) {
+		builds++
+		return stuck, nil
+	}, WithShutdownTimeout(20*time.Millisecond))
+	_ = MustInvokeNamed[*scopeTestStuckShutdowner](injector, "stuck")
+
+	// the abandoned shutdown does not lock the service
+	report := injector.Reset()
+	desc := newServiceDescription(injector.ID(), injector.Name(), "stuck")
+	is.Equal([]ServiceDescription{desc}, report.TimedOut)
+
+	_, err := InvokeNamed[*scopeTestStuckShutdowner](injector, "stuck")
+	is.NoError(err)
+	is.Equal(2, builds)
+}
+
+func TestScope_ShutdownTimeout_injectorOpts(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 200*time.Millisecond)
+	is := assert.New(t)
+
+	injector := NewWithOpts(&InjectorOpts{ShutdownTimeout: 20 * time.Millisecond})
+	child := injector.Scope("child")
+
+	stuck := &scopeTestStuckShutdowner{release: make(chan struct{})}
+	defer close(stuck.release)
+
+	ProvideNamedValue(child, "stuck", stuck)
+	slow := newScopeTestSlowShutdowner(50 * time.Millisecond)
+	ProvideNamedValue(injector, "slow", slow, WithShutdownTimeout(time.Second))
+	ProvideNamedValue(injector, "ok", &lazyTestShutdownerOK{})
+
+	report := injector.Shutdown()
+
+	// the registration option overrides the injector default
+	is.Len(report.Services, 3)
+	is.Len(report.Errors, 1)
+	is.Equal([]ServiceDescription{newServiceDescription(child.ID(), child.Name(), "stuck")}, report.TimedOut)
+	is.Equal(1, slow.getShutdownCount())
+}
+
+func TestScope_ShutdownWithContext_crossScopeDependencies(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	recorder := &drainTestRecorder{}
+	injector := New()
+	child := injector.Scope("child")
+	grandchild := child.Scope("grandchild")
+
+	ProvideNamedValue(injector, "api", &drainTestService{name: "api", recorder: recorder})
+	ProvideNamedValue(injector, "config", &drainTestService{name: "config", recorder: recorder})
+	ProvideNamedValue(child, "conn", &drainTestService{name: "conn", recorder: recorder})
+	ProvideNamedValue(grandchild, "session", &drainTestService{name: "session", recorder: recorder})
+
+	// a root service depending on a child service
+	injector.dag.addDependency(injector.ID(), injector.Name(), "api", child.ID(), child.Name(), "conn")
+
+	report := injector.Shutdown()
+	is.True(report.Succeed)
+	is.Len(report.Services, 4)
+	is.Equal(
+		[]ServiceDependency{
+			{
+				Dependent:  newServiceDescription(injector.ID(), injector.Name(), "api"),
+				Dependency: newServiceDescription(child.ID(), child.Name(), "conn"),
+			},
+		},
+		report.CrossScopeDependencies,
+	)
+
+	// the dependency takes precedence over the child-first rule, which remains a tie-breaker
+	is.Equal(
+		[]string{
+			"drain session", "drain api", "drain conn", "drain config",
+			"shutdown session", "shutdown api", "shutdown conn", "shutdown config",
+		},
+		recorder.list(),
+	)
+	is.Empty(injector.Children())
+	is.Empty(child.Children())
+}
+
+var _ ShutdownerWithContextAndError = (*scopeTestConcurrentShutdowner)(nil)
+
+type scopeTestConcurrentShutdowner struct {
+	running    *int32
+	maxRunning *int32
+}
+
+func (s *scopeTestConcurrentShutdowner) Shutdown(ctx context.Context) error {
+	current := atomic.AddInt32(s.running, 1)
+	defer atomic.AddInt32(s.running, -1)
+
+	for {
+		previous := atomic.LoadInt32(s.maxRunning)
+		if current <= previous || atomic.CompareAndSwapInt32(s.maxRunning, previous, current) {
+			break
+		}
+	}
+
+	time.Sleep(5 * time.Millisecond)
+	return nil
+}
+
+func TestScope_ShutdownParallelism(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 200*time.Millisecond)
+	is := assert.New(t)
+
+	var running, maxRunning int32
+
+	injector := NewWithOpts(&InjectorOpts{ShutdownParallelism: 2})
+	for _, name := range []string{"a", "b", "c", "d", "e", "f"} {
+		ProvideNamedValue(injector, name, &scopeTestConcurrentShutdowner{running: &running, maxRunning: &maxRunning})
+	}
+
+	report := injector.Shutdown()
+	is.True(report.Succeed)
+	is.Len(report.Services, 6)
+	is.EqualValues(2, atomic.LoadInt32(&maxRunning))
+
+	// the pool is stopped once the shutdown is over
+	is.Nil(injector.shutdownPool)
+
+	// and started again by the next shutdown or reset
+	atomic.StoreInt32(&maxRunning, 0)
+	for _, name := range []string{"a", "b", "c", "d", "e", "f"} {
+		ProvideNamed(injector, name, func(i Injector) (*scopeTestConcurrentShutdowner, error) {
+			return &scopeTestConcurrentShutdowner{running: &running, maxRunning: &maxRunning}, nil
+		})
+		_ = MustInvokeNamed[*scopeTestConcurrentShutdowner](injector, name)
+	}
+
+	report = injector.Reset()
+	is.True(report.Succeed)
+	is.Len(report.Services, 6)
+	is.EqualValues(2, atomic.LoadInt32(&maxRunning))
+	is.Nil(injector.shutdownPool)
+}
+
+func TestScope_ShutdownParallelism_sequential(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	for i := 0; i < 5; i++ {
+		recorder := &drainTestRecorder{}
+		injector := NewWithOpts(&InjectorOpts{ShutdownParallelism: 1})
+		child := injector.Scope("child")
+
+		for _, name := range []string{"c", "a", "b"} {
+			ProvideNamedValue(injector, name, &drainTestService{name: name, recorder: recorder})
+		}
+		ProvideNamedValue(child, "z", &drainTestService{name: "z", recorder: recorder})
+		ProvideNamedValue(child, "y", &drainTestService{name: "y", recorder: recorder})
+
+		report := injector.Shutdown()
+		is.True(report.Succeed)
+
+		// children first, then sorted by scope name and service name within each wave
+		is.Equal(
+			[]string{
+				"drain y", "drain z", "drain a", "drain b", "drain c",
+				"shutdown y", "shutdown z", "shutdown a", "shutdown b", "shutdown c",
+			},
+			recorder.list(),
+		)
+		is.Equal(
+			[]ServiceDescription{
+				newServiceDescription(child.ID(), child.Name(), "y"),
+				newServiceDescription(child.ID(), child.Name(), "z"),
+				newServiceDescription(injector.ID(), injector.Name(), "a"),
+				newServiceDescription(injector.ID(), injector.Name(), "b"),
+				newServiceDescription(injector.ID(), injector.Name(), "c"),
+			},
+			report.Services,
+		)
+	}
+}
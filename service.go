@@ -57,10 +57,14 @@ type serviceWrapper[T any] interface {
 	getInstance(Injector) (T, error)
 	isHealthchecker() bool
 	healthcheck(context.Context) error
+	isChecker(HealthCheckKind) bool
+	check(context.Context, HealthCheckKind) error
 	isShutdowner() bool
 	shutdown(context.Context) error
 	clone(Injector) any
 	source() (stacktrace.Frame, []stacktrace.Frame)
+	getOptions() serviceOptions
+	setOptions(serviceOptions)
 }
 
 // serviceWrapperAny is a non-generic version of serviceWrapper[T] that provides access to
@@ -75,10 +79,14 @@ type serviceWrapperAny interface {
 	// getInstance(Injector) (T, error) - Not available in non-generic interface
 	isHealthchecker() bool
 	healthcheck(context.Context) error
+	isChecker(HealthCheckKind) bool
+	check(context.Context, HealthCheckKind) error
 	isShutdowner() bool
 	shutdown(context.Context) error
 	clone(Injector) any
 	source() (stacktrace.Frame, []stacktrace.Frame)
+	getOptions() serviceOptions
+	setOptions(serviceOptions)
 }
 
 // Interface definitions for specific service capabilities.
@@ -94,18 +102,43 @@ type (
 	serviceWrapperGetInstance[T any] interface{ getInstance(Injector) (T, error) } //nolint:unused
 	serviceWrapperIsHealthchecker    interface{ isHealthchecker() bool }
 	serviceWrapperHealthcheck        interface{ healthcheck(context.Context) error }
-	serviceWrapperIsShutdowner       interface{ isShutdowner() bool }
-	serviceWrapperShutdown           interface{ shutdown(context.Context) error }
-	serviceWrapperClone              interface{ clone(Injector) any }
-	serviceWrapperSource             interface {
+	serviceWrapperIsChecker          interface{ isChecker(HealthCheckKind) bool }
+	serviceWrapperCheck              interface {
+		check(context.Context, HealthCheckKind) error
+	}
+	serviceWrapperIsShutdowner interface{ isShutdowner() bool }
+	serviceWrapperShutdown     interface{ shutdown(context.Context) error }
+	serviceWrapperClone        interface{ clone(Injector) any }
+	serviceWrapperSource       interface {
 		source() (stacktrace.Frame, []stacktrace.Frame)
 	}
+	serviceWrapperGetOptions interface{ getOptions() serviceOptions }
+	serviceWrapperSetOptions interface{ setOptions(serviceOptions) }
 )
 
 type serviceWrapperBuildTime interface {
 	getBuildTime() (time.Duration, bool)
 }
 
+// serviceWrapperTrackedInstances returns the number of instances of a transient service waiting
+// for shutdown, and whether the instances are tracked. untrackInstance releases a tracked instance.
+type serviceWrapperTrackedInstances interface {
+	countTrackedInstances() (int, bool)
+	untrackInstance(any) bool
+}
+
+// serviceWrapperBuiltInstance returns the instance of a service, without building it.
+type serviceWrapperBuiltInstance interface {
+	getBuiltInstance() (any, bool)
+}
+
+// serviceWrapperReset returns a service to its registered but unbuilt state: see Scope.Reset.
+// isBuilt returns false when the service holds no instance to release.
+type serviceWrapperReset interface {
+	isBuilt() bool
+	reset(context.Context) error
+}
+
 // Interface compliance checks to ensure serviceWrapper[T] implements all required interfaces.
 // These compile-time checks help catch interface implementation errors early.
 var (
@@ -116,10 +149,14 @@ var (
 	_ serviceWrapperGetInstanceAny  = (serviceWrapper[int])(nil)
 	_ serviceWrapperIsHealthchecker = (serviceWrapper[int])(nil)
 	_ serviceWrapperHealthcheck     = (serviceWrapper[int])(nil)
+	_ serviceWrapperIsChecker       = (serviceWrapper[int])(nil)
+	_ serviceWrapperCheck           = (serviceWrapper[int])(nil)
 	_ serviceWrapperIsShutdowner    = (serviceWrapper[int])(nil)
 	_ serviceWrapperShutdown        = (serviceWrapper[int])(nil)
 	_ serviceWrapperClone           = (serviceWrapper[int])(nil)
 	_ serviceWrapperSource          = (serviceWrapper[int])(nil)
+	_ serviceWrapperGetOptions      = (serviceWrapper[int])(nil)
+	_ serviceWrapperSetOptions      = (serviceWrapper[int])(nil)
 )
 
 // inferServiceName uses type inference to determine the service name
@@ -145,6 +182,8 @@ type serviceInfo struct {
 	serviceType      ServiceType
 	serviceBuildTime time.Duration
 	healthchecker    bool
+	readinessChecker bool
+	livenessChecker  bool
 	shutdowner       bool
 }
 
@@ -155,18 +194,40 @@ func inferServiceInfo(injector Injector, name string) (serviceInfo, bool) {
 			buildTime, _ = lazy.getBuildTime()
 		}
 
+		// A borrowed service is not checked nor shut down by the container.
+		options := getServiceOptions(serviceAny)
+		checked := options.isCheckEnabled()
+
 		return serviceInfo{
 			name:             name,
 			serviceType:      serviceAny.(serviceWrapperGetServiceType).getServiceType(), //nolint:errcheck,forcetypeassert
 			serviceBuildTime: buildTime,
-			healthchecker:    serviceAny.(serviceWrapperIsHealthchecker).isHealthchecker(), //nolint:errcheck,forcetypeassert
-			shutdowner:       serviceAny.(serviceWrapperIsShutdowner).isShutdowner(),       //nolint:errcheck,forcetypeassert
+			healthchecker:    checked && serviceAny.(serviceWrapperIsHealthchecker).isHealthchecker(),               //nolint:errcheck,forcetypeassert
+			readinessChecker: checked && serviceAny.(serviceWrapperIsChecker).isChecker(HealthCheckKindReadiness),   //nolint:errcheck,forcetypeassert
+			livenessChecker:  checked && serviceAny.(serviceWrapperIsChecker).isChecker(HealthCheckKindLiveness),    //nolint:errcheck,forcetypeassert
+			shutdowner:       options.isShutdownEnabled() && serviceAny.(serviceWrapperIsShutdowner).isShutdowner(), //nolint:errcheck,forcetypeassert
 		}, true
 	}
 
 	return serviceInfo{}, false
 }
 
+// serviceHasInstance reports whether a service currently holds a built instance.
+// Transient services never hold an instance.
+func serviceHasInstance(service any) bool {
+	if svc, ok := service.(serviceWrapperGetServiceType); ok && svc.getServiceType() == ServiceTypeTransient {
+		return false
+	}
+
+	if svc, ok := service.(serviceWrapperBuiltInstance); ok {
+		_, built := svc.getBuiltInstance()
+		return built
+	}
+
+	// aliases
+	return true
+}
+
 func serviceCanCastToGeneric[T any](service any) bool {
 	if svc, ok := service.(serviceWrapperGetReflectType); ok {
 		// we need type reflection here, because we don't want to invoke the service when not needed
@@ -26,6 +26,8 @@ type serviceAlias[Initial any, Alias any] struct {
 	providerFrame           stacktrace.Frame
 	invokationFrames        map[stacktrace.Frame]struct{} // map garanties uniqueness
 	invokationFramesCounter uint32
+
+	options serviceOptions
 }
 
 func newServiceAlias[Initial any, Alias any](
@@ -45,6 +47,8 @@ func newServiceAlias[Initial any, Alias any](
 		providerFrame:           providerFrame,
 		invokationFrames:        map[stacktrace.Frame]struct{}{},
 		invokationFramesCounter: 0,
+
+		options: newServiceOptions(nil),
 	}
 }
 
@@ -125,6 +129,34 @@ func (s *serviceAlias[Initial, Alias]) healthcheck(ctx context.Context) error {
 	return service.healthcheck(ctx)
 }
 
+func (s *serviceAlias[Initial, Alias]) isChecker(kind HealthCheckKind) bool {
+	serviceAny, _, ok := s.scope.serviceGetRec(s.targetName)
+	if !ok {
+		return false
+	}
+
+	service, ok := serviceAny.(serviceWrapperIsChecker)
+	if !ok {
+		return false
+	}
+
+	return service.isChecker(kind)
+}
+
+func (s *serviceAlias[Initial, Alias]) check(ctx context.Context, kind HealthCheckKind) error {
+	serviceAny, _, ok := s.scope.serviceGetRec(s.targetName)
+	if !ok {
+		return nil
+	}
+
+	service, ok := serviceAny.(serviceWrapperCheck)
+	if !ok {
+		return nil
+	}
+
+	return service.check(ctx, kind)
+}
+
 func (s *serviceAlias[Initial, Alias]) isShutdowner() bool {
 	serviceAny, _, ok := s.scope.serviceGetRec(s.targetName)
 	if !ok {
@@ -164,6 +196,8 @@ func (s *serviceAlias[Initial, Alias]) clone(newScope Injector) any {
 		providerFrame:           s.providerFrame,
 		invokationFrames:        map[stacktrace.Frame]struct{}{},
 		invokationFramesCounter: 0,
+
+		options: s.options,
 	}
 }
 
@@ -177,3 +211,11 @@ func (s *serviceAlias[Initial, Alias]) source() (stacktrace.Frame, []stacktrace.
 
 	return s.providerFrame, invokationFrames
 }
+
+func (s *serviceAlias[Initial, Alias]) getOptions() serviceOptions {
+	return s.options
+}
+
+func (s *serviceAlias[Initial, Alias]) setOptions(options serviceOptions) {
+	s.options = options
+}
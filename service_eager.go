@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/samber/do/v2/stacktrace"
 )
@@ -14,6 +15,7 @@ var (
 	_ serviceWrapperHealthcheck = (*serviceEager[int])(nil)
 	_ serviceWrapperShutdown    = (*serviceEager[int])(nil)
 	_ serviceWrapperClone       = (*serviceEager[int])(nil)
+	_ serviceWrapperBuildTime   = (*serviceEager[int])(nil)
 )
 
 type serviceEager[T any] struct {
@@ -21,10 +23,16 @@ type serviceEager[T any] struct {
 	typeName string
 	instance T
 
+	// only set when the instance has been built from a provider
+	built     bool
+	buildTime time.Duration
+
 	providerFrame           stacktrace.Frame
 	invokationFrames        map[stacktrace.Frame]struct{} // map garanties uniqueness
 	invokationFramesMu      sync.RWMutex
 	invokationFramesCounter uint32
+
+	options serviceOptions
 }
 
 func newServiceEager[T any](name string, instance T) *serviceEager[T] {
@@ -39,9 +47,40 @@ func newServiceEager[T any](name string, instance T) *serviceEager[T] {
 		invokationFrames:        map[stacktrace.Frame]struct{}{},
 		invokationFramesMu:      sync.RWMutex{},
 		invokationFramesCounter: 0,
+
+		options: newServiceOptions(nil),
 	}
 }
 
+// newServiceEagerFromProvider runs the provider immediately and wraps the resulting
+// instance. The provider receives a virtual scope, so that its invocations are
+// recorded in the DAG, exactly like a lazy service being built.
+func newServiceEagerFromProvider[T any](name string, i Injector, provider Provider[T]) (*serviceEager[T], error) {
+	providerFrame, _ := stacktrace.NewFrameFromPC(reflect.ValueOf(provider).Pointer())
+
+	start := time.Now()
+
+	instance, err := handleProviderPanic(provider, newInvocationScope(i, i, []string{name}))
+	if err != nil {
+		return nil, err
+	}
+
+	return &serviceEager[T]{
+		name:      name,
+		typeName:  inferServiceName[T](),
+		instance:  instance,
+		built:     true,
+		buildTime: time.Since(start),
+
+		providerFrame:           providerFrame,
+		invokationFrames:        map[stacktrace.Frame]struct{}{},
+		invokationFramesMu:      sync.RWMutex{},
+		invokationFramesCounter: 0,
+
+		options: newServiceOptions(nil),
+	}, nil
+}
+
 func (s *serviceEager[T]) getName() string {
 	return s.name
 }
@@ -79,27 +118,19 @@ func (s *serviceEager[T]) getInstance(i Injector) (T, error) {
 }
 
 func (s *serviceEager[T]) isHealthchecker() bool {
-	_, ok1 := any(s.instance).(HealthcheckerWithContext)
-	_, ok2 := any(s.instance).(Healthchecker)
-	return ok1 || ok2
+	return s.isChecker(HealthCheckKindHealth)
 }
 
 func (s *serviceEager[T]) healthcheck(ctx context.Context) error {
-	if instance, ok := any(s.instance).(HealthcheckerWithContext); ok {
-		if ctx.Err() != nil {
-			return ctx.Err()
-		}
-
-		return instance.HealthCheck(ctx)
-	} else if instance, ok := any(s.instance).(Healthchecker); ok {
-		if ctx.Err() != nil {
-			return ctx.Err()
-		}
+	return s.check(ctx, HealthCheckKindHealth)
+}
 
-		return instance.HealthCheck()
-	}
+func (s *serviceEager[T]) isChecker(kind HealthCheckKind) bool {
+	return instanceIsChecker(s.instance, kind)
+}
 
-	return nil
+func (s *serviceEager[T]) check(ctx context.Context, kind HealthCheckKind) error {
+	return instanceCheck(ctx, s.instance, kind)
 }
 
 func (s *serviceEager[T]) isShutdowner() bool {
@@ -147,10 +178,15 @@ func (s *serviceEager[T]) clone(newScope Injector) any {
 		typeName: s.typeName,
 		instance: s.instance,
 
+		built:     s.built,
+		buildTime: s.buildTime,
+
 		providerFrame:           s.providerFrame,
 		invokationFrames:        map[stacktrace.Frame]struct{}{},
 		invokationFramesMu:      sync.RWMutex{},
 		invokationFramesCounter: 0,
+
+		options: s.options,
 	}
 }
 
@@ -164,3 +200,20 @@ func (s *serviceEager[T]) source() (stacktrace.Frame, []stacktrace.Frame) {
 
 	return s.providerFrame, invokationFrames
 }
+
+func (s *serviceEager[T]) getOptions() serviceOptions {
+	return s.options
+}
+
+func (s *serviceEager[T]) setOptions(options serviceOptions) {
+	s.options = options
+}
+
+func (s *serviceEager[T]) getBuiltInstance() (any, bool) {
+	return s.instance, true
+}
+
+// getBuildTime returns false for values, that were not built by the container.
+func (s *serviceEager[T]) getBuildTime() (time.Duration, bool) {
+	return s.buildTime, s.built
+}
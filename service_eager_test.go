@@ -178,6 +178,60 @@ func TestNewServiceEager(t *testing.T) {
 	is.Equal(m, service9.instance)
 }
 
+func TestNewServiceEagerFromProvider(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	i := New()
+	ProvideValue(i, &eagerTest{foobar: "dependency"})
+
+	provider := func(i Injector) (int, error) {
+		dep := MustInvoke[*eagerTest](i)
+		if dep.foobar != "dependency" {
+			return 0, assert.AnError
+		}
+
+		time.Sleep(5 * time.Millisecond)
+		return 42, nil
+	}
+
+	service, err := newServiceEagerFromProvider("foobar", i, provider)
+	is.NoError(err)
+	is.Equal("foobar", service.name)
+	is.Equal("int", service.typeName)
+	is.Equal(42, service.instance)
+	is.GreaterOrEqual(service.buildTime, 5*time.Millisecond)
+	is.Contains(service.providerFrame.Function, "TestNewServiceEagerFromProvider")
+
+	buildTime, ok := service.getBuildTime()
+	is.True(ok)
+	is.Equal(service.buildTime, buildTime)
+
+	// dependency has been recorded in the DAG
+	dependencies, _ := i.dag.explainService(i.ID(), i.Name(), "foobar")
+	is.Equal([]ServiceDescription{newServiceDescription(i.ID(), i.Name(), NameOf[*eagerTest]())}, dependencies)
+
+	// provider error
+	service, err = newServiceEagerFromProvider("foobar", i, func(i Injector) (int, error) {
+		return 0, assert.AnError
+	})
+	is.ErrorIs(err, assert.AnError)
+	is.Nil(service)
+
+	// provider panic
+	service, err = newServiceEagerFromProvider("foobar", i, func(i Injector) (int, error) {
+		panic("aïe")
+	})
+	is.EqualError(err, "DI: aïe")
+	is.Nil(service)
+
+	// value services have no build time
+	buildTime, ok = newServiceEager("foobar", 42).getBuildTime()
+	is.False(ok)
+	is.Zero(buildTime)
+}
+
 func TestServiceEager_getName(t *testing.T) {
 	t.Parallel()
 	testWithTimeout(t, 100*time.Millisecond)
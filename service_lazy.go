@@ -15,6 +15,7 @@ var (
 	_ serviceWrapperHealthcheck = (*serviceLazy[int])(nil)
 	_ serviceWrapperShutdown    = (*serviceLazy[int])(nil)
 	_ serviceWrapperClone       = (*serviceLazy[int])(nil)
+	_ serviceWrapperReset       = (*serviceLazy[int])(nil)
 )
 
 type serviceLazy[T any] struct {
@@ -31,6 +32,8 @@ type serviceLazy[T any] struct {
 	providerFrame           stacktrace.Frame
 	invokationFrames        map[stacktrace.Frame]struct{} // map garanties uniqueness
 	invokationFramesCounter uint32
+
+	options serviceOptions
 }
 
 func newServiceLazy[T any](name string, provider Provider[T]) *serviceLazy[T] {
@@ -48,6 +51,8 @@ func newServiceLazy[T any](name string, provider Provider[T]) *serviceLazy[T] {
 		providerFrame:           providerFrame,
 		invokationFrames:        map[stacktrace.Frame]struct{}{},
 		invokationFramesCounter: 0,
+
+		options: newServiceOptions(nil),
 	}
 }
 
@@ -113,6 +118,14 @@ func (s *serviceLazy[T]) build(i Injector) (err error) {
 }
 
 func (s *serviceLazy[T]) isHealthchecker() bool {
+	return s.isChecker(HealthCheckKindHealth)
+}
+
+func (s *serviceLazy[T]) healthcheck(ctx context.Context) error {
+	return s.check(ctx, HealthCheckKindHealth)
+}
+
+func (s *serviceLazy[T]) isChecker(kind HealthCheckKind) bool {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
@@ -120,12 +133,10 @@ func (s *serviceLazy[T]) isHealthchecker() bool {
 		return false
 	}
 
-	_, ok1 := any(s.instance).(HealthcheckerWithContext)
-	_, ok2 := any(s.instance).(Healthchecker)
-	return ok1 || ok2
+	return instanceIsChecker(s.instance, kind)
 }
 
-func (s *serviceLazy[T]) healthcheck(ctx context.Context) error {
+func (s *serviceLazy[T]) check(ctx context.Context, kind HealthCheckKind) error {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
@@ -133,22 +144,7 @@ func (s *serviceLazy[T]) healthcheck(ctx context.Context) error {
 		return nil
 	}
 
-	switch instance := any(s.instance).(type) {
-	case HealthcheckerWithContext:
-		if ctx.Err() != nil {
-			return ctx.Err()
-		}
-
-		return instance.HealthCheck(ctx)
-	case Healthchecker:
-		if ctx.Err() != nil {
-			return ctx.Err()
-		}
-
-		return instance.HealthCheck()
-	}
-
-	return nil
+	return instanceCheck(ctx, s.instance, kind)
 }
 
 func (s *serviceLazy[T]) isShutdowner() bool {
@@ -167,47 +163,32 @@ func (s *serviceLazy[T]) isShutdowner() bool {
 }
 
 func (s *serviceLazy[T]) shutdown(ctx context.Context) error {
+	// Whatever the outcome, the `built` flag and the instance are reset. The lock is released before
+	// the instance is shut down: a shutdown abandoned after its timeout must not block the service.
 	s.mu.Lock()
+	built := s.built
+	instance := s.instance
+	s.built = false
+	s.instance = empty[T]()
+	s.mu.Unlock()
 
-	defer func() {
-		// whatever the outcome, reset `build` flag and instance
-		s.built = false
-		s.instance = empty[T]()
-		s.mu.Unlock()
-	}()
-
-	if !s.built {
+	if !built {
 		return nil
 	}
 
-	switch instance := any(s.instance).(type) {
-	case ShutdownerWithContextAndError:
-		if ctx.Err() != nil {
-			return ctx.Err()
-		}
-
-		return instance.Shutdown(ctx)
-	case ShutdownerWithError:
-		if ctx.Err() != nil {
-			return ctx.Err()
-		}
-
-		return instance.Shutdown()
-	case ShutdownerWithContext:
-		if ctx.Err() != nil {
-			return ctx.Err()
-		}
+	return instanceShutdown(ctx, instance)
+}
 
-		instance.Shutdown(ctx)
-	case Shutdowner:
-		if ctx.Err() != nil {
-			return ctx.Err()
-		}
+func (s *serviceLazy[T]) isBuilt() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 
-		instance.Shutdown()
-	}
+	return s.built
+}
 
-	return nil
+// reset shuts down the instance, if any. The next invocation calls the provider again.
+func (s *serviceLazy[T]) reset(ctx context.Context) error {
+	return s.shutdown(ctx)
 }
 
 func (s *serviceLazy[T]) clone(newScope Injector) any {
@@ -224,6 +205,8 @@ func (s *serviceLazy[T]) clone(newScope Injector) any {
 		providerFrame:           s.providerFrame,
 		invokationFrames:        map[stacktrace.Frame]struct{}{},
 		invokationFramesCounter: 0,
+
+		options: s.options,
 	}
 }
 
@@ -238,6 +221,28 @@ func (s *serviceLazy[T]) source() (stacktrace.Frame, []stacktrace.Frame) {
 	return s.providerFrame, invokationFrames
 }
 
+func (s *serviceLazy[T]) getOptions() serviceOptions {
+	return s.options
+}
+
+func (s *serviceLazy[T]) setOptions(options serviceOptions) {
+	s.options = options
+}
+
+func (s *serviceLazy[T]) getBuiltInstance() (any, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if !s.built {
+		return nil, false
+	}
+
+	return s.instance, true
+}
+
 func (s *serviceLazy[T]) getBuildTime() (time.Duration, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
 	return s.buildTime, s.built
 }
@@ -0,0 +1,231 @@
+package do
+
+import "time"
+
+// ServiceOption configures a service at registration.
+//
+// Example:
+//
+//	do.Provide(injector, NewCache, do.WithCriticality(do.CriticalityNonCritical))
+type ServiceOption func(*serviceOptions)
+
+// serviceOptions holds the options of a service. They are set once, before the service
+// is stored in its scope, and are read-only afterward.
+type serviceOptions struct {
+	criticality     Criticality
+	shutdownTimeout time.Duration
+	trackInstances  bool
+	ownership       Ownership
+	checkBorrowed   bool
+	stopBorrowed    bool
+	shutdownAfter   []string
+	shutdownBefore  []string
+	shutdownPhase   int
+	restartPolicy   RestartPolicy
+	restartBackoff  time.Duration
+	maxBackoff      time.Duration
+}
+
+func newServiceOptions(opts []ServiceOption) serviceOptions {
+	options := serviceOptions{
+		criticality:    CriticalityCritical,
+		ownership:      OwnershipOwned,
+		restartPolicy:  RestartPolicyNever,
+		restartBackoff: DefaultRestartBackoff,
+		maxBackoff:     DefaultMaxRestartBackoff,
+	}
+
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	return options
+}
+
+// Criticality defines how the failure of a service health check affects the overall health status.
+type Criticality string
+
+const (
+	// CriticalityCritical is the default criticality: a failing service makes the injector unhealthy.
+	CriticalityCritical Criticality = "critical"
+
+	// CriticalityNonCritical is for optional services, such as a cache: a failing service only degrades the injector.
+	CriticalityNonCritical Criticality = "non-critical"
+)
+
+// WithCriticality sets the criticality of a service. See HealthReport.
+func WithCriticality(criticality Criticality) ServiceOption {
+	return func(o *serviceOptions) {
+		o.criticality = criticality
+	}
+}
+
+// WithShutdownTimeout sets the shutdown timeout of a service, overriding InjectorOpts.ShutdownTimeout.
+// A service overrunning its timeout is abandoned, and reported in ShutdownReport.TimedOut.
+func WithShutdownTimeout(timeout time.Duration) ServiceOption {
+	return func(o *serviceOptions) {
+		o.shutdownTimeout = timeout
+	}
+}
+
+// WithTransientTracking keeps a reference to every instance of a transient service implementing a
+// Shutdowner interface, so that the instances are shut down with the scope of the service. It has
+// no effect on lazy and eager services, that are shut down anyway.
+//
+// Instances stay in memory until the scope shuts down, unless released with ReleaseTransient: they
+// are not garbage collected before. Use it for services holding resources, such as files or
+// connections, created a bounded number of times, or release the instances once closed.
+func WithTransientTracking() ServiceOption {
+	return func(o *serviceOptions) {
+		o.trackInstances = true
+	}
+}
+
+// Ownership defines whether the container manages the lifecycle of a service.
+type Ownership string
+
+const (
+	// OwnershipOwned is the default ownership: the service is health checked and shut down by the container.
+	OwnershipOwned Ownership = "owned"
+
+	// OwnershipBorrowed is for values owned by the caller, or shared between injectors, such as a client
+	// created in main(): the service is neither drained, shut down nor health checked by the container,
+	// unless requested with WithBorrowedShutdown or WithBorrowedHealthCheck.
+	OwnershipBorrowed Ownership = "borrowed"
+)
+
+// WithOwnership sets the ownership of a service. See OwnershipBorrowed.
+//
+// Example:
+//
+//	do.ProvideValue(injector, sharedClient, do.WithOwnership(do.OwnershipBorrowed))
+func WithOwnership(ownership Ownership) ServiceOption {
+	return func(o *serviceOptions) {
+		o.ownership = ownership
+	}
+}
+
+// WithBorrowedHealthCheck includes a borrowed service in health checks. It is still not shut down,
+// unless registered with WithBorrowedShutdown.
+// It has no effect on owned services, that are health checked anyway.
+func WithBorrowedHealthCheck() ServiceOption {
+	return func(o *serviceOptions) {
+		o.checkBorrowed = true
+	}
+}
+
+// WithBorrowedShutdown includes a borrowed service in the drain and the shutdown of its scope, for
+// values handed over to the container. It is still not health checked, unless registered with
+// WithBorrowedHealthCheck.
+// It has no effect on owned services, that are shut down anyway.
+func WithBorrowedShutdown() ServiceOption {
+	return func(o *serviceOptions) {
+		o.stopBorrowed = true
+	}
+}
+
+// WithShutdownAfter shuts down the service after the given services, even when it does not depend
+// on them. Names refer to the services of the same scope, its ancestors and its descendants, such as
+// do.NameOf[T](). Unknown services are ignored.
+//
+// Example:
+//
+//	do.Provide(injector, NewMetricsFlusher, do.WithShutdownAfter(do.NameOf[*Worker]()))
+func WithShutdownAfter(names ...string) ServiceOption {
+	return func(o *serviceOptions) {
+		o.shutdownAfter = append(o.shutdownAfter, names...)
+	}
+}
+
+// WithShutdownBefore shuts down the service before the given services, even when they do not depend
+// on it. See WithShutdownAfter.
+func WithShutdownBefore(names ...string) ServiceOption {
+	return func(o *serviceOptions) {
+		o.shutdownBefore = append(o.shutdownBefore, names...)
+	}
+}
+
+// WithShutdownPhase sets the shutdown phase of the service. The services of a phase are shut down
+// after the services of lower phases. The default phase is 0: use a positive phase to shut down a
+// service last, such as a tracer exporter, and a negative phase to shut it down first.
+func WithShutdownPhase(phase int) ServiceOption {
+	return func(o *serviceOptions) {
+		o.shutdownPhase = phase
+	}
+}
+
+// RestartPolicy defines when the container restarts the Run method of a service implementing Runner.
+type RestartPolicy string
+
+const (
+	// RestartPolicyNever is the default restart policy: Run is started once.
+	RestartPolicyNever RestartPolicy = "never"
+
+	// RestartPolicyOnFailure restarts Run when it returns an error or panics, after a backoff.
+	RestartPolicyOnFailure RestartPolicy = "on-failure"
+
+	// RestartPolicyAlways restarts Run whenever it returns, after a backoff.
+	RestartPolicyAlways RestartPolicy = "always"
+)
+
+// Default delays before restarting a service implementing Runner. See WithRestartBackoff.
+const (
+	DefaultRestartBackoff    = 100 * time.Millisecond
+	DefaultMaxRestartBackoff = 30 * time.Second
+)
+
+// WithRestartPolicy sets the restart policy of a service implementing Runner. See Runner.
+//
+// Example:
+//
+//	do.Provide(injector, NewConsumer, do.WithRestartPolicy(do.RestartPolicyOnFailure))
+func WithRestartPolicy(policy RestartPolicy) ServiceOption {
+	return func(o *serviceOptions) {
+		o.restartPolicy = policy
+	}
+}
+
+// WithRestartBackoff sets the delay before restarting a service implementing Runner. The delay is
+// doubled after each restart, up to maxBackoff, and reset when a run lasts longer than maxBackoff.
+//
+// A backoff lower than or equal to zero is replaced by DefaultRestartBackoff, so that a Run returning
+// immediately is not restarted in a hot loop. A maxBackoff lower than backoff is raised to backoff.
+func WithRestartBackoff(backoff time.Duration, maxBackoff time.Duration) ServiceOption {
+	if backoff <= 0 {
+		backoff = DefaultRestartBackoff
+	}
+	if maxBackoff < backoff {
+		maxBackoff = backoff
+	}
+
+	return func(o *serviceOptions) {
+		o.restartBackoff = backoff
+		o.maxBackoff = maxBackoff
+	}
+}
+
+// isShutdownEnabled returns false when the container must not drain nor shut down the service.
+func (o serviceOptions) isShutdownEnabled() bool {
+	return o.ownership != OwnershipBorrowed || o.stopBorrowed
+}
+
+// isCheckEnabled returns false when the container must not health check the service.
+func (o serviceOptions) isCheckEnabled() bool {
+	return o.ownership != OwnershipBorrowed || o.checkBorrowed
+}
+
+// setServiceOptions applies the registration options to a service.
+func setServiceOptions(service any, opts []ServiceOption) {
+	if svc, ok := service.(serviceWrapperSetOptions); ok {
+		svc.setOptions(newServiceOptions(opts))
+	}
+}
+
+// getServiceOptions returns the registration options of a service.
+func getServiceOptions(service any) serviceOptions {
+	if svc, ok := service.(serviceWrapperGetOptions); ok {
+		return svc.getOptions()
+	}
+
+	return newServiceOptions(nil)
+}
@@ -0,0 +1,173 @@
+package do
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewServiceOptions(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	is.Equal(CriticalityCritical, newServiceOptions(nil).criticality)
+	is.Equal(CriticalityNonCritical, newServiceOptions([]ServiceOption{WithCriticality(CriticalityNonCritical)}).criticality)
+}
+
+func TestServiceOptions_registration(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	i := New()
+
+	ProvideNamed(i, "lazy", func(i Injector) (int, error) { return 1, nil }, WithCriticality(CriticalityNonCritical))
+	ProvideNamedValue(i, "eager", 2, WithCriticality(CriticalityNonCritical))
+	ProvideNamedTransient(i, "transient", func(i Injector) (int, error) { return 3, nil }, WithCriticality(CriticalityNonCritical))
+	is.NoError(ProvideNamedEager(i, "eager-provider", func(i Injector) (int, error) { return 4, nil }, WithCriticality(CriticalityNonCritical)))
+	ProvideNamedValue(i, "default", 5)
+	Package(LazyNamed("package", func(i Injector) (int, error) { return 6, nil }, WithCriticality(CriticalityNonCritical)))(i)
+
+	for _, name := range []string{"lazy", "eager", "transient", "eager-provider", "package"} {
+		service, ok := i.serviceGet(name)
+		is.True(ok)
+		is.Equal(CriticalityNonCritical, getServiceOptions(service).criticality, name)
+	}
+
+	service, _ := i.serviceGet("default")
+	is.Equal(CriticalityCritical, getServiceOptions(service).criticality)
+
+	// options are kept by clones
+	clone := i.Clone()
+	service, _ = clone.serviceGet("lazy")
+	is.Equal(CriticalityNonCritical, getServiceOptions(service).criticality)
+
+	// and replaced by overrides
+	OverrideNamed(i, "lazy", func(i Injector) (int, error) { return 1, nil })
+	service, _ = i.serviceGet("lazy")
+	is.Equal(CriticalityCritical, getServiceOptions(service).criticality)
+
+	is.Equal(CriticalityCritical, getServiceOptions(42).criticality)
+}
+
+type serviceOptionsTestShared struct {
+	shutdown bool
+	drained  bool
+}
+
+func (s *serviceOptionsTestShared) HealthCheck() error {
+	return assert.AnError
+}
+
+func (s *serviceOptionsTestShared) Drain(ctx context.Context) error {
+	s.drained = true
+	return nil
+}
+
+func (s *serviceOptionsTestShared) Shutdown() error {
+	s.shutdown = true
+	return nil
+}
+
+func TestServiceOptions_restartBackoff(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	options := newServiceOptions([]ServiceOption{WithRestartBackoff(time.Second, time.Minute)})
+	is.Equal(time.Second, options.restartBackoff)
+	is.Equal(time.Minute, options.maxBackoff)
+
+	// a zero backoff is clamped
+	options = newServiceOptions([]ServiceOption{WithRestartBackoff(0, 0)})
+	is.Equal(DefaultRestartBackoff, options.restartBackoff)
+	is.Equal(DefaultRestartBackoff, options.maxBackoff)
+
+	options = newServiceOptions([]ServiceOption{WithRestartBackoff(-time.Second, time.Minute)})
+	is.Equal(DefaultRestartBackoff, options.restartBackoff)
+	is.Equal(time.Minute, options.maxBackoff)
+
+	// maxBackoff is at least backoff
+	options = newServiceOptions([]ServiceOption{WithRestartBackoff(time.Second, time.Millisecond)})
+	is.Equal(time.Second, options.restartBackoff)
+	is.Equal(time.Second, options.maxBackoff)
+}
+
+func TestServiceOptions_ownership(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	is.Equal(OwnershipOwned, newServiceOptions(nil).ownership)
+	is.True(newServiceOptions(nil).isShutdownEnabled())
+	is.True(newServiceOptions(nil).isCheckEnabled())
+
+	borrowed := &serviceOptionsTestShared{}
+	checked := &serviceOptionsTestShared{}
+	owned := &serviceOptionsTestShared{}
+	handedOver := &serviceOptionsTestShared{}
+
+	i := New()
+	ProvideNamedValue(i, "borrowed", borrowed, WithOwnership(OwnershipBorrowed))
+	ProvideNamedValue(i, "checked", checked, WithOwnership(OwnershipBorrowed), WithBorrowedHealthCheck())
+	ProvideNamedValue(i, "owned", owned, WithOwnership(OwnershipOwned), WithBorrowedHealthCheck())
+	ProvideNamedValue(i, "handed-over", handedOver, WithOwnership(OwnershipBorrowed), WithBorrowedShutdown())
+
+	// borrowed services are excluded from health checks, unless requested
+	is.Equal(
+		map[string]error{"borrowed": nil, "checked": assert.AnError, "owned": assert.AnError, "handed-over": nil},
+		i.HealthCheck(),
+	)
+
+	report := i.HealthReport(HealthReportOpts{})
+	_, ok := report.Get(newServiceDescription(i.ID(), i.Name(), "borrowed"))
+	is.False(ok)
+	_, ok = report.Get(newServiceDescription(i.ID(), i.Name(), "checked"))
+	is.True(ok)
+
+	// the choice is explained
+	output, ok := ExplainNamedService(i, "borrowed")
+	is.True(ok)
+	is.Equal(OwnershipBorrowed, output.Ownership)
+	is.False(output.BorrowedHealthCheck)
+	is.Contains(output.String(), "Ownership: borrowed (not shut down, not health checked)")
+
+	output, _ = ExplainNamedService(i, "checked")
+	is.True(output.BorrowedHealthCheck)
+	is.False(output.BorrowedShutdown)
+	is.Contains(output.String(), "Ownership: borrowed (not shut down)\n")
+
+	output, _ = ExplainNamedService(i, "handed-over")
+	is.False(output.BorrowedHealthCheck)
+	is.True(output.BorrowedShutdown)
+	is.Contains(output.String(), "Ownership: borrowed (not health checked)")
+
+	output, _ = ExplainNamedService(i, "owned")
+	is.Equal(OwnershipOwned, output.Ownership)
+	is.False(output.BorrowedHealthCheck)
+	is.NotContains(output.String(), "Ownership")
+
+	injectorOutput := ExplainInjector(i)
+	explained := injectorOutput.String()
+	is.Contains(explained, " owned 🫀 🙅")
+	is.Contains(explained, " checked 🫀\n")
+	is.Contains(explained, " borrowed\n")
+	is.Contains(explained, " handed-over 🙅")
+
+	// borrowed services are removed from the container, but neither drained nor shut down
+	shutdown := i.Shutdown()
+	is.True(shutdown.Succeed)
+	is.Len(shutdown.Services, 4)
+	is.Empty(i.ListProvidedServices())
+	is.False(borrowed.drained)
+	is.False(borrowed.shutdown)
+	is.False(checked.drained)
+	is.False(checked.shutdown)
+	is.True(owned.drained)
+	is.True(owned.shutdown)
+	is.True(handedOver.drained)
+	is.True(handedOver.shutdown)
+}
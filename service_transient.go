@@ -2,7 +2,9 @@ package do
 
 import (
 	"context"
+	"fmt"
 	"reflect"
+	"sync"
 
 	"github.com/samber/do/v2/stacktrace"
 )
@@ -12,6 +14,9 @@ var (
 	_ serviceWrapperHealthcheck = (*serviceTransient[int])(nil)
 	_ serviceWrapperShutdown    = (*serviceTransient[int])(nil)
 	_ serviceWrapperClone       = (*serviceTransient[int])(nil)
+
+	_ serviceWrapperTrackedInstances = (*serviceTransient[int])(nil)
+	_ serviceWrapperReset            = (*serviceTransient[int])(nil)
 )
 
 type serviceTransient[T any] struct {
@@ -20,6 +25,12 @@ type serviceTransient[T any] struct {
 
 	// lazy loading
 	provider Provider[T]
+
+	// instances implementing a Shutdowner interface, when tracked: see WithTransientTracking
+	instances   []any
+	instancesMu sync.Mutex
+
+	options serviceOptions
 }
 
 func newServiceTransient[T any](name string, provider Provider[T]) *serviceTransient[T] {
@@ -28,6 +39,8 @@ func newServiceTransient[T any](name string, provider Provider[T]) *serviceTrans
 		typeName: inferServiceName[T](),
 
 		provider: provider,
+
+		options: newServiceOptions(nil),
 	}
 }
 
@@ -52,7 +65,14 @@ func (s *serviceTransient[T]) getInstanceAny(i Injector) (any, error) {
 }
 
 func (s *serviceTransient[T]) getInstance(i Injector) (T, error) {
-	return handleProviderPanic(s.provider, i)
+	instance, err := handleProviderPanic(s.provider, i)
+	if err == nil && s.options.trackInstances && instanceIsShutdowner(any(instance)) {
+		s.instancesMu.Lock()
+		s.instances = append(s.instances, instance)
+		s.instancesMu.Unlock()
+	}
+
+	return instance, err
 }
 
 func (s *serviceTransient[T]) isHealthchecker() bool {
@@ -65,22 +85,93 @@ func (s *serviceTransient[T]) healthcheck(ctx context.Context) error {
 	return nil
 }
 
-func (s *serviceTransient[T]) isShutdowner() bool {
+func (s *serviceTransient[T]) isChecker(kind HealthCheckKind) bool {
 	return false
 }
 
-func (s *serviceTransient[T]) shutdown(ctx context.Context) error {
-	// @TODO: implement shutdown ?
-	// It requires to store each instance of service, which is not good because of memory leaks.
+func (s *serviceTransient[T]) check(ctx context.Context, kind HealthCheckKind) error {
 	return nil
 }
 
+func (s *serviceTransient[T]) isShutdowner() bool {
+	count, tracked := s.countTrackedInstances()
+	return tracked && count > 0
+}
+
+// shutdown shuts down the tracked instances, the most recent first. Without WithTransientTracking,
+// instances are not referenced by the container, and must be shut down by the caller.
+func (s *serviceTransient[T]) shutdown(ctx context.Context) error {
+	s.instancesMu.Lock()
+	instances := s.instances
+	s.instances = nil
+	s.instancesMu.Unlock()
+
+	var firstErr error
+	failed := 0
+
+	for i := len(instances) - 1; i >= 0; i-- {
+		if err := instanceShutdown(ctx, instances[i]); err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			failed++
+		}
+	}
+
+	if failed > 1 {
+		return fmt.Errorf("DI: %d of %d transient instances failed to shut down: %w", failed, len(instances), firstErr)
+	}
+
+	return firstErr
+}
+
+func (s *serviceTransient[T]) countTrackedInstances() (int, bool) {
+	s.instancesMu.Lock()
+	defer s.instancesMu.Unlock()
+
+	return len(s.instances), s.options.trackInstances
+}
+
+// untrackInstance stops tracking an instance, without shutting it down. It returns false when the
+// instance is not tracked.
+func (s *serviceTransient[T]) untrackInstance(instance any) bool {
+	if instance == nil || !reflect.TypeOf(instance).Comparable() {
+		return false
+	}
+
+	s.instancesMu.Lock()
+	defer s.instancesMu.Unlock()
+
+	for i := range s.instances {
+		if s.instances[i] == instance {
+			last := len(s.instances) - 1
+			copy(s.instances[i:], s.instances[i+1:])
+			s.instances[last] = nil // let the instance be garbage collected
+			s.instances = s.instances[:last]
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *serviceTransient[T]) isBuilt() bool {
+	return s.isShutdowner()
+}
+
+// reset shuts down the tracked instances. The provider is called on every invocation anyway.
+func (s *serviceTransient[T]) reset(ctx context.Context) error {
+	return s.shutdown(ctx)
+}
+
 func (s *serviceTransient[T]) clone(newScope Injector) any {
 	return &serviceTransient[T]{
 		name:     s.name,
 		typeName: s.typeName,
 
 		provider: s.provider,
+
+		options: s.options,
 	}
 }
 
@@ -89,3 +180,11 @@ func (s *serviceTransient[T]) source() (stacktrace.Frame, []stacktrace.Frame) {
 	// It requires to store each instance of service, which is not good because of memory leaks.
 	return stacktrace.Frame{}, []stacktrace.Frame{}
 }
+
+func (s *serviceTransient[T]) getOptions() serviceOptions {
+	return s.options
+}
+
+func (s *serviceTransient[T]) setOptions(options serviceOptions) {
+	s.options = options
+}
@@ -582,6 +582,178 @@ func TestServiceTransient_shutdown(t *testing.T) {
 	is.NoError(service6.shutdown(ctx)) // Should still return nil even with void shutdowner
 }
 
+type transientTestTracked struct {
+	id       int
+	shutdown *[]int
+	err      error
+}
+
+func (t *transientTestTracked) Shutdown() error {
+	*t.shutdown = append(*t.shutdown, t.id)
+	return t.err
+}
+
+func TestServiceTransient_tracking(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	ctx := context.Background()
+	shutdown := []int{}
+	count := 0
+
+	service := newServiceTransient("foobar", func(i Injector) (*transientTestTracked, error) {
+		count++
+		return &transientTestTracked{id: count, shutdown: &shutdown}, nil
+	})
+	service.setOptions(newServiceOptions([]ServiceOption{WithTransientTracking()}))
+
+	n, tracked := service.countTrackedInstances()
+	is.True(tracked)
+	is.Equal(0, n)
+	is.False(service.isShutdowner())
+
+	for i := 0; i < 3; i++ {
+		_, err := service.getInstance(nil)
+		is.NoError(err)
+	}
+
+	n, _ = service.countTrackedInstances()
+	is.Equal(3, n)
+	is.True(service.isShutdowner())
+
+	// the most recent instances first
+	is.NoError(service.shutdown(ctx))
+	is.Equal([]int{3, 2, 1}, shutdown)
+
+	// references are released
+	n, _ = service.countTrackedInstances()
+	is.Equal(0, n)
+	is.NoError(service.shutdown(ctx))
+	is.Equal([]int{3, 2, 1}, shutdown)
+
+	// clones do not share instances
+	_, _ = service.getInstance(nil)
+	clone, ok := service.clone(nil).(*serviceTransient[*transientTestTracked])
+	is.True(ok)
+	n, tracked = clone.countTrackedInstances()
+	is.True(tracked)
+	is.Equal(0, n)
+
+	// errors
+	failing := newServiceTransient("foobar", func(i Injector) (*transientTestTracked, error) {
+		return &transientTestTracked{shutdown: &shutdown, err: assert.AnError}, nil
+	})
+	failing.setOptions(newServiceOptions([]ServiceOption{WithTransientTracking()}))
+	_, _ = failing.getInstance(nil)
+	is.ErrorIs(failing.shutdown(ctx), assert.AnError)
+	_, _ = failing.getInstance(nil)
+	_, _ = failing.getInstance(nil)
+	err := failing.shutdown(ctx)
+	is.ErrorIs(err, assert.AnError)
+	is.Contains(err.Error(), "2 of 2 transient instances failed to shut down")
+
+	// instances not implementing a Shutdowner interface are not tracked
+	values := newServiceTransient("foobar", func(i Injector) (int, error) {
+		return 42, nil
+	})
+	values.setOptions(newServiceOptions([]ServiceOption{WithTransientTracking()}))
+	_, _ = values.getInstance(nil)
+	n, tracked = values.countTrackedInstances()
+	is.True(tracked)
+	is.Equal(0, n)
+
+	// not tracked by default
+	untracked := newServiceTransient("foobar", func(i Injector) (*transientTestTracked, error) {
+		return &transientTestTracked{shutdown: &shutdown}, nil
+	})
+	_, _ = untracked.getInstance(nil)
+	n, tracked = untracked.countTrackedInstances()
+	is.False(tracked)
+	is.Equal(0, n)
+}
+
+func TestReleaseTransient(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	shutdown := []int{}
+	count := 0
+
+	injector := New()
+	child := injector.Scope("child")
+	ProvideTransient(injector, func(i Injector) (*transientTestTracked, error) {
+		count++
+		return &transientTestTracked{id: count, shutdown: &shutdown}, nil
+	}, WithTransientTracking())
+	ProvideNamedValue(injector, "value", 42)
+
+	first := MustInvoke[*transientTestTracked](injector)
+	second := MustInvoke[*transientTestTracked](child)
+	third := MustInvoke[*transientTestTracked](injector)
+
+	// released instances are not tracked anymore, and not shut down
+	is.True(ReleaseTransient(child, second))
+	is.False(ReleaseTransient(injector, second))
+	is.True(ReleaseTransientNamed(injector, NameOf[*transientTestTracked](), first))
+
+	explain, _ := ExplainService[*transientTestTracked](injector)
+	is.Equal(1, explain.TrackedInstances)
+
+	// unknown instance or service
+	is.False(ReleaseTransient(injector, &transientTestTracked{}))
+	is.False(ReleaseTransientNamed(injector, NameOf[*transientTestTracked](), nil))
+	is.False(ReleaseTransientNamed(injector, NameOf[*transientTestTracked](), []int{}))
+	is.False(ReleaseTransientNamed(injector, "value", 42))
+	is.False(ReleaseTransientNamed(injector, "not-found", third))
+
+	report := injector.Shutdown()
+	is.True(report.Succeed)
+	is.Equal([]int{third.id}, shutdown)
+}
+
+func TestProvideTransient_tracking(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	shutdown := []int{}
+	count := 0
+
+	injector := New()
+	ProvideNamedTransient(injector, "tracked", func(i Injector) (*transientTestTracked, error) {
+		count++
+		return &transientTestTracked{id: count, shutdown: &shutdown}, nil
+	}, WithTransientTracking())
+	ProvideNamedTransient(injector, "untracked", func(i Injector) (*transientTestTracked, error) {
+		return &transientTestTracked{id: -1, shutdown: &shutdown}, nil
+	})
+
+	_ = MustInvokeNamed[*transientTestTracked](injector, "tracked")
+	_ = MustInvokeNamed[*transientTestTracked](injector, "tracked")
+	_ = MustInvokeNamed[*transientTestTracked](injector, "untracked")
+
+	explain, ok := ExplainNamedService(injector, "tracked")
+	is.True(ok)
+	is.True(explain.TransientTracking)
+	is.Equal(2, explain.TrackedInstances)
+	is.Contains(explain.String(), "Tracked instances: 2")
+
+	explain, ok = ExplainNamedService(injector, "untracked")
+	is.True(ok)
+	is.False(explain.TransientTracking)
+	is.NotContains(explain.String(), "Tracked instances")
+
+	report := injector.Shutdown()
+	is.True(report.Succeed)
+	is.Equal([]int{2, 1}, shutdown)
+	is.Equal(
+		map[ServiceDescription]int{newServiceDescription(injector.ID(), injector.Name(), "tracked"): 2},
+		report.TransientInstances,
+	)
+}
+
 func TestServiceTransient_clone(t *testing.T) {
 	t.Parallel()
 	testWithTimeout(t, 100*time.Millisecond)
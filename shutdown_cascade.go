@@ -0,0 +1,96 @@
+package do
+
+import (
+	"context"
+	"time"
+)
+
+// shutdownCascade shuts down a service and the services depending on it, directly or not,
+// across scopes. Like shutdownServicesInParallel, services are shut down by waves: the services
+// having no dependent first, in parallel.
+func (s *Scope) shutdownCascade(ctx context.Context, name string) *ShutdownReport {
+	start := time.Now()
+
+	report := &ShutdownReport{
+		Succeed:                true,
+		Services:               []ServiceDescription{},
+		Errors:                 map[ServiceDescription]error{},
+		ShutdownTime:           0,
+		ServiceShutdownTime:    map[ServiceDescription]time.Duration{},
+		TimedOut:               []ServiceDescription{},
+		TransientInstances:     map[ServiceDescription]int{},
+		CrossScopeDependencies: []ServiceDependency{},
+		Conflicts:              []ShutdownConflict{},
+		Pending:                []ServiceDescription{},
+		RunnerErrors:           map[ServiceDescription]error{},
+	}
+
+	if !s.serviceExist(name) {
+		report.Errors[newServiceDescription(s.id, s.name, name)] = serviceNotFound(s, ErrServiceNotFound, []string{name})
+		report.Succeed = false
+		report.ShutdownTime = time.Since(start)
+		return report
+	}
+
+	pending := s.listDependentsRec(name)
+	scopes := s.rootScope.scopesByID()
+
+	for len(pending) > 0 {
+		wave := s.rootScope.dag.listServicesHavingNoDependent(pending)
+		if len(wave) == 0 {
+			// In this branch, we expect that there is a circular dependency. We shutdown all services, without taking care of order.
+			wave = keys(pending)
+		}
+
+		for _, desc := range wave {
+			delete(pending, desc)
+		}
+
+		report = mergeShutdownReports(report, s.rootScope.shutdownServicesWithoutDependenciesInParallel(ctx, scopes, wave))
+	}
+
+	report.ShutdownTime = time.Since(start)
+	report.Succeed = len(report.Errors) == 0
+
+	return report
+}
+
+// listDependentsRec returns the service and its dependents, directly or not, according to the DAG.
+func (s *Scope) listDependentsRec(name string) map[ServiceDescription]struct{} {
+	root := newServiceDescription(s.id, s.name, name)
+	visited := map[ServiceDescription]struct{}{root: {}}
+	queue := []ServiceDescription{root}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		_, dependents := s.rootScope.dag.explainService(current.ScopeID, current.ScopeName, current.Service)
+		for _, dependent := range dependents {
+			if _, ok := visited[dependent]; ok {
+				continue
+			}
+
+			visited[dependent] = struct{}{}
+			queue = append(queue, dependent)
+		}
+	}
+
+	return visited
+}
+
+// scopesByID returns the scopes of the tree, keyed by ID.
+func (s *RootScope) scopesByID() map[string]*Scope {
+	scopes := map[string]*Scope{}
+
+	var walk func(scope *Scope)
+	walk = func(scope *Scope) {
+		scopes[scope.id] = scope
+		for _, child := range scope.Children() {
+			walk(child)
+		}
+	}
+	walk(s.self)
+
+	return scopes
+}
@@ -0,0 +1,187 @@
+package do
+
+// ShutdownConflict is a shutdown ordering constraint that was not honored, because it contradicts
+// the DAG or another constraint: see WithShutdownAfter, WithShutdownBefore and WithShutdownPhase.
+type ShutdownConflict struct {
+	// Service is the service shut down in spite of its constraints.
+	Service ServiceDescription
+	// Waiting lists the pending services it should have been shut down after.
+	Waiting []ServiceDescription
+}
+
+// shutdownConstraints holds the ordering constraints of the services being shut down, on top of
+// the DAG.
+type shutdownConstraints struct {
+	phases map[ServiceDescription]int
+	after  map[ServiceDescription][]ServiceDescription // services to shut down before the key
+	before map[ServiceDescription][]ServiceDescription // services to shut down after the key
+}
+
+// newShutdownConstraints resolves the constraints of the pending services. A service name refers to
+// the services with this name in the same scope, its ancestors and its descendants. Unknown names
+// are ignored.
+func newShutdownConstraints(scopes map[string]*Scope, pending map[ServiceDescription]struct{}, ancestors map[string][]string) shutdownConstraints {
+	constraints := shutdownConstraints{
+		phases: map[ServiceDescription]int{},
+		after:  map[ServiceDescription][]ServiceDescription{},
+		before: map[ServiceDescription][]ServiceDescription{},
+	}
+
+	byName := map[string][]ServiceDescription{}
+	for desc := range pending {
+		byName[desc.Service] = append(byName[desc.Service], desc)
+	}
+
+	isAncestor := func(ancestor string, scopeID string) bool {
+		for _, id := range ancestors[scopeID] {
+			if id == ancestor {
+				return true
+			}
+		}
+		return false
+	}
+
+	resolve := func(desc ServiceDescription, name string) []ServiceDescription {
+		return filter(byName[name], func(candidate ServiceDescription, _ int) bool {
+			return candidate != desc &&
+				(candidate.ScopeID == desc.ScopeID || isAncestor(candidate.ScopeID, desc.ScopeID) || isAncestor(desc.ScopeID, candidate.ScopeID))
+		})
+	}
+
+	for desc := range pending {
+		scope, ok := scopes[desc.ScopeID]
+		if !ok {
+			continue
+		}
+
+		serviceAny, ok := scope.serviceGet(desc.Service)
+		if !ok {
+			continue
+		}
+
+		options := getServiceOptions(serviceAny)
+
+		if options.shutdownPhase != 0 {
+			constraints.phases[desc] = options.shutdownPhase
+		}
+
+		for _, name := range options.shutdownAfter {
+			for _, target := range resolve(desc, name) {
+				constraints.add(target, desc)
+			}
+		}
+
+		for _, name := range options.shutdownBefore {
+			for _, target := range resolve(desc, name) {
+				constraints.add(desc, target)
+			}
+		}
+	}
+
+	return constraints
+}
+
+// add records that first must be shut down before then.
+func (c shutdownConstraints) add(first ServiceDescription, then ServiceDescription) {
+	c.after[then] = append(c.after[then], first)
+	c.before[first] = append(c.before[first], then)
+}
+
+// minPhase returns the lowest phase of the pending services.
+func (c shutdownConstraints) minPhase(pending map[ServiceDescription]struct{}) int {
+	first := true
+	lowest := 0
+
+	for desc := range pending {
+		if phase := c.phases[desc]; first || phase < lowest {
+			lowest = phase
+			first = false
+		}
+	}
+
+	return lowest
+}
+
+// hasPendingPredecessor returns true when a pending service must be shut down before the service,
+// according to WithShutdownAfter and WithShutdownBefore.
+func (c shutdownConstraints) hasPendingPredecessor(desc ServiceDescription, pending map[ServiceDescription]struct{}) bool {
+	for _, predecessor := range c.after[desc] {
+		if _, ok := pending[predecessor]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// waiting returns the pending services that must be shut down before the service, according to
+// the constraints and phases.
+func (c shutdownConstraints) waiting(desc ServiceDescription, pending map[ServiceDescription]struct{}) []ServiceDescription {
+	seen := map[ServiceDescription]struct{}{}
+
+	for _, predecessor := range c.after[desc] {
+		if _, ok := pending[predecessor]; ok {
+			seen[predecessor] = struct{}{}
+		}
+	}
+
+	for other := range pending {
+		if c.phases[other] < c.phases[desc] {
+			seen[other] = struct{}{}
+		}
+	}
+
+	waiting := keys(seen)
+	sortServiceDescriptions(waiting)
+
+	return waiting
+}
+
+// ready filters the services having no pending dependent, according to the constraints. When the
+// constraints block every service, they contradict the DAG or each other: WithShutdownAfter and
+// WithShutdownBefore are ignored first, then the phases, and the services shut down too early are
+// reported.
+func (c shutdownConstraints) ready(ready []ServiceDescription, pending map[ServiceDescription]struct{}) ([]ServiceDescription, []ShutdownConflict) {
+	minPhase := c.minPhase(pending)
+
+	candidates := filter(ready, func(desc ServiceDescription, _ int) bool {
+		return c.phases[desc] == minPhase && !c.hasPendingPredecessor(desc, pending)
+	})
+	if len(candidates) > 0 || len(ready) == 0 {
+		return candidates, nil
+	}
+
+	candidates = filter(ready, func(desc ServiceDescription, _ int) bool {
+		return c.phases[desc] == minPhase
+	})
+
+	if len(candidates) == 0 {
+		candidates = filter(ready, func(desc ServiceDescription, _ int) bool {
+			return !c.hasPendingPredecessor(desc, pending)
+		})
+		if len(candidates) == 0 {
+			candidates = ready
+		}
+
+		lowest := c.phases[candidates[0]]
+		for _, desc := range candidates {
+			if c.phases[desc] < lowest {
+				lowest = c.phases[desc]
+			}
+		}
+		candidates = filter(candidates, func(desc ServiceDescription, _ int) bool {
+			return c.phases[desc] == lowest
+		})
+	}
+
+	sortServiceDescriptions(candidates)
+
+	conflicts := mAp(candidates, func(desc ServiceDescription, _ int) ShutdownConflict {
+		return ShutdownConflict{
+			Service: desc,
+			Waiting: c.waiting(desc, pending),
+		}
+	})
+
+	return candidates, conflicts
+}
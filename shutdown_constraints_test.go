@@ -0,0 +1,138 @@
+package do
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScope_ShutdownWithContext_constraints(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	recorder := &drainTestRecorder{}
+	injector := New()
+
+	provide := func(name string, opts ...ServiceOption) {
+		ProvideNamed(injector, name, func(i Injector) (*drainTestService, error) {
+			if name == "api" {
+				_ = MustInvokeNamed[*drainTestService](i, "db")
+			}
+			return &drainTestService{name: name, recorder: recorder}, nil
+		}, opts...)
+	}
+
+	provide("db")
+	provide("api")
+	provide("cache", WithShutdownBefore("api"))
+	provide("audit", WithShutdownAfter("db", "unknown"))
+	provide("flusher", WithShutdownPhase(1))
+	provide("tracer", WithShutdownPhase(2))
+
+	for _, name := range []string{"api", "cache", "audit", "flusher", "tracer"} {
+		_ = MustInvokeNamed[*drainTestService](injector, name)
+	}
+
+	plan := injector.ShutdownPlan()
+	is.Equal(
+		[]PlanWave{
+			{Services: planTestWave(injector, "cache")},
+			{Services: planTestWave(injector, "api")},
+			{Services: planTestWave(injector, "db")},
+			{Services: planTestWave(injector, "audit")},
+			{Services: planTestWave(injector, "flusher")},
+			{Services: planTestWave(injector, "tracer")},
+		},
+		plan.Waves,
+	)
+	is.Empty(plan.Conflicts)
+
+	report := injector.Shutdown()
+	is.True(report.Succeed)
+	is.Empty(report.Conflicts)
+	is.Equal(
+		[]string{"shutdown cache", "shutdown api", "shutdown db", "shutdown audit", "shutdown flusher", "shutdown tracer"},
+		filter(recorder.list(), func(event string, _ int) bool { return event[:5] != "drain" }),
+	)
+}
+
+func TestScope_ShutdownWithContext_constraintsAcrossScopes(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	injector := New()
+	child := injector.Scope("child")
+
+	ProvideNamedValue(injector, "queue", 1, WithShutdownBefore("worker"))
+	ProvideNamedValue(injector, "config", 2)
+	ProvideNamedValue(child, "worker", 3)
+
+	// the constraint takes precedence over the child-first rule
+	is.Equal(
+		[]PlanWave{
+			{Services: planTestWave(injector, "queue")},
+			{Services: planTestWave(child, "worker")},
+			{Services: planTestWave(injector, "config")},
+		},
+		injector.ShutdownPlan().Waves,
+	)
+}
+
+func TestScope_ShutdownWithContext_constraintsConflicts(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	injector := New()
+
+	ProvideNamed(injector, "db", func(i Injector) (int, error) { return 1, nil }, WithShutdownBefore("api"))
+	ProvideNamed(injector, "api", func(i Injector) (int, error) {
+		return MustInvokeNamed[int](i, "db"), nil
+	})
+	ProvideNamed(injector, "cache", func(i Injector) (int, error) { return 1, nil })
+	ProvideNamed(injector, "server", func(i Injector) (int, error) {
+		return MustInvokeNamed[int](i, "cache"), nil
+	}, WithShutdownPhase(1))
+
+	_ = MustInvokeNamed[int](injector, "api")
+	_ = MustInvokeNamed[int](injector, "server")
+
+	api := newServiceDescription(injector.ID(), injector.Name(), "api")
+	db := newServiceDescription(injector.ID(), injector.Name(), "db")
+	cache := newServiceDescription(injector.ID(), injector.Name(), "cache")
+	server := newServiceDescription(injector.ID(), injector.Name(), "server")
+
+	// the DAG wins: dependents are shut down first
+	plan := injector.ShutdownPlan()
+	is.Equal(
+		[]PlanWave{
+			{Services: planTestWave(injector, "api")},
+			{Services: planTestWave(injector, "db")},
+			{Services: planTestWave(injector, "server")},
+			{Services: planTestWave(injector, "cache")},
+		},
+		plan.Waves,
+	)
+	is.Equal(
+		[]PlanConflict{
+			{Service: newPlanService(api), Waiting: []PlanService{newPlanService(db)}},
+			{Service: newPlanService(server), Waiting: []PlanService{newPlanService(cache)}},
+		},
+		plan.Conflicts,
+	)
+	is.Contains(plan.String(), "  conflict: [root] > api is shut down before [root] > db")
+	is.Contains(plan.String(), "  conflict: [root] > server is shut down before [root] > cache")
+
+	report := injector.Shutdown()
+	is.True(report.Succeed)
+	is.Equal(
+		[]ShutdownConflict{
+			{Service: api, Waiting: []ServiceDescription{db}},
+			{Service: server, Waiting: []ServiceDescription{cache}},
+		},
+		report.Conflicts,
+	)
+}
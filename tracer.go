@@ -0,0 +1,118 @@
+package do
+
+import (
+	"context"
+)
+
+// Names of the operations traced by the injector.
+const (
+	SpanNameBuild          = "do.build"
+	SpanNameHealthCheck    = "do.healthcheck"
+	SpanNameReadinessCheck = "do.readinesscheck"
+	SpanNameLivenessCheck  = "do.livenesscheck"
+	SpanNameShutdown       = "do.shutdown"
+)
+
+// Tracer opens spans for service builds, health checks and shutdowns.
+// Build spans are nested along the invoker chain: the span of a dependency built
+// by a provider is a child of the span of this provider.
+//
+// It can be implemented on top of OpenTelemetry or any other tracing library.
+type Tracer interface {
+	Start(ctx context.Context, spanName string, attrs SpanAttributes) (context.Context, Span)
+}
+
+// Span is a traced operation, opened by a Tracer.
+type Span interface {
+	// End closes the span. The error is nil when the operation succeeded.
+	End(err error)
+}
+
+// SpanAttributes describes the service involved in a traced operation.
+type SpanAttributes struct {
+	ScopeID      string
+	ScopeName    string
+	ServiceName  string
+	ServiceType  ServiceType
+	InvokerChain []string // Only set for build spans
+}
+
+type noopSpan struct{}
+
+func (noopSpan) End(error) {}
+
+// startSpan opens a span with the configured tracer, or a no-op span when no tracer is set.
+func (o *InjectorOpts) startSpan(ctx context.Context, spanName string, scope *Scope, serviceName string, service any, invokerChain []string) (context.Context, Span) {
+	if o.Tracer == nil {
+		return ctx, noopSpan{}
+	}
+
+	attrs := SpanAttributes{
+		ServiceName:  serviceName,
+		InvokerChain: invokerChain,
+	}
+
+	if scope != nil {
+		attrs.ScopeID = scope.id
+		attrs.ScopeName = scope.name
+	}
+
+	if svc, ok := service.(serviceWrapperGetServiceType); ok {
+		attrs.ServiceType = svc.getServiceType()
+	}
+
+	return o.Tracer.Start(ctx, spanName, attrs)
+}
+
+func spanNameOfCheck(kind HealthCheckKind) string {
+	switch kind {
+	case HealthCheckKindReadiness:
+		return SpanNameReadinessCheck
+	case HealthCheckKindLiveness:
+		return SpanNameLivenessCheck
+	case HealthCheckKindHealth:
+	}
+
+	return SpanNameHealthCheck
+}
+
+// startBuildSpan opens the span of a provider build. The injector passed to the provider
+// is a virtual scope carrying the span context, so that the dependencies built by the
+// provider are traced as children of this span.
+func startBuildSpan(i Injector) (Injector, context.Context, Span) {
+	vScope, ok := i.(*virtualScope)
+	if !ok || len(vScope.invokerChain) == 0 {
+		return i, context.Background(), noopSpan{}
+	}
+
+	opts := vScope.RootScope().opts
+	if opts.Tracer == nil {
+		return i, vScope.invocationContext(), noopSpan{}
+	}
+
+	serviceName := vScope.invokerChain[len(vScope.invokerChain)-1]
+	scope := injectorScope(vScope)
+
+	var service any
+	if scope != nil {
+		service, _ = scope.serviceGet(serviceName)
+	}
+
+	ctx, span := opts.startSpan(vScope.invocationContext(), SpanNameBuild, scope, serviceName, service, append([]string{}, vScope.invokerChain...))
+
+	return vScope.withContext(ctx), ctx, span
+}
+
+// injectorScope returns the concrete scope behind an injector.
+func injectorScope(i Injector) *Scope {
+	switch injector := i.(type) {
+	case *Scope:
+		return injector
+	case *RootScope:
+		return injector.self
+	case *virtualScope:
+		return injectorScope(injector.self)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,201 @@
+package do
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testTracerSpanKey struct{}
+
+type testTracerSpan struct {
+	tracer *testTracer
+	id     int
+	parent int
+	name   string
+	attrs  SpanAttributes
+	ended  bool
+	err    error
+}
+
+func (s *testTracerSpan) End(err error) {
+	s.tracer.mu.Lock()
+	defer s.tracer.mu.Unlock()
+
+	s.ended = true
+	s.err = err
+}
+
+// testTracer is an in-memory recorder of spans.
+type testTracer struct {
+	mu    sync.Mutex
+	spans []*testTracerSpan
+}
+
+func (t *testTracer) Start(ctx context.Context, spanName string, attrs SpanAttributes) (context.Context, Span) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	parent := 0
+	if p, ok := ctx.Value(testTracerSpanKey{}).(*testTracerSpan); ok {
+		parent = p.id
+	}
+
+	span := &testTracerSpan{tracer: t, id: len(t.spans) + 1, parent: parent, name: spanName, attrs: attrs}
+	t.spans = append(t.spans, span)
+
+	return context.WithValue(ctx, testTracerSpanKey{}, span), span
+}
+
+func (t *testTracer) find(spanName string, serviceName string) *testTracerSpan {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, span := range t.spans {
+		if span.name == spanName && span.attrs.ServiceName == serviceName {
+			return span
+		}
+	}
+
+	return nil
+}
+
+type tracerTestInitializer struct {
+	ctx context.Context
+}
+
+func (t *tracerTestInitializer) Init(ctx context.Context) error {
+	t.ctx = ctx
+	return nil
+}
+
+func TestTracer_build(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	tracer := &testTracer{}
+	i := NewWithOpts(&InjectorOpts{Tracer: tracer})
+	scope := i.Scope("child")
+
+	ProvideNamed(scope, "a", func(i Injector) (int, error) {
+		_, err := InvokeNamed[string](i, "b")
+		return 1, err
+	})
+	ProvideNamed(i, "b", func(i Injector) (string, error) {
+		_, err := InvokeNamed[*tracerTestInitializer](i, "c")
+		return "b", err
+	})
+	ProvideNamedTransient(i, "c", func(i Injector) (*tracerTestInitializer, error) {
+		return &tracerTestInitializer{}, nil
+	})
+	ProvideNamed(i, "ko", func(i Injector) (bool, error) { return false, assert.AnError })
+
+	_, err := InvokeNamed[int](scope, "a")
+	is.NoError(err)
+
+	a := tracer.find(SpanNameBuild, "a")
+	b := tracer.find(SpanNameBuild, "b")
+	c := tracer.find(SpanNameBuild, "c")
+	is.NotNil(a)
+	is.NotNil(b)
+	is.NotNil(c)
+
+	is.Equal(0, a.parent)
+	is.Equal(a.id, b.parent)
+	is.Equal(b.id, c.parent)
+
+	is.Equal(SpanAttributes{ScopeID: scope.ID(), ScopeName: "child", ServiceName: "a", ServiceType: ServiceTypeLazy, InvokerChain: []string{"a"}}, a.attrs)
+	is.Equal(SpanAttributes{ScopeID: i.ID(), ScopeName: "[root]", ServiceName: "b", ServiceType: ServiceTypeLazy, InvokerChain: []string{"a", "b"}}, b.attrs)
+	is.Equal(ServiceTypeTransient, c.attrs.ServiceType)
+	is.True(a.ended)
+	is.True(b.ended)
+	is.True(c.ended)
+	is.NoError(a.err)
+
+	// the initializer receives the span context
+	instance, err := InvokeNamed[*tracerTestInitializer](i, "c")
+	is.NoError(err)
+	is.NotNil(instance.ctx.Value(testTracerSpanKey{}))
+
+	// cache hits are not traced
+	count := len(tracer.spans)
+	_, _ = InvokeNamed[int](scope, "a")
+	is.Len(tracer.spans, count)
+
+	// errors
+	_, err = InvokeNamed[bool](i, "ko")
+	is.ErrorIs(err, assert.AnError)
+	ko := tracer.find(SpanNameBuild, "ko")
+	is.NotNil(ko)
+	is.True(ko.ended)
+	is.ErrorIs(ko.err, assert.AnError)
+}
+
+func TestTracer_eagerFromProvider(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	tracer := &testTracer{}
+	i := NewWithOpts(&InjectorOpts{Tracer: tracer})
+
+	ProvideNamedValue(i, "dep", 42)
+	err := ProvideNamedEager(i, "eager", func(i Injector) (int, error) {
+		return InvokeNamed[int](i, "dep")
+	})
+	is.NoError(err)
+
+	span := tracer.find(SpanNameBuild, "eager")
+	is.NotNil(span)
+	is.True(span.ended)
+	is.Equal([]string{"eager"}, span.attrs.InvokerChain)
+}
+
+func TestTracer_healthcheckAndShutdown(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	tracer := &testTracer{}
+	i := NewWithOpts(&InjectorOpts{Tracer: tracer})
+
+	ProvideNamedValue(i, "healthcheck", &lazyTestHeathcheckerKO{})
+	ProvideNamedValue(i, "shutdown", &lazyTestShutdownerOK{})
+
+	_ = i.HealthCheck()
+	span := tracer.find(SpanNameHealthCheck, "healthcheck")
+	is.NotNil(span)
+	is.True(span.ended)
+	is.Error(span.err)
+	is.Equal(ServiceTypeEager, span.attrs.ServiceType)
+	is.Equal(i.ID(), span.attrs.ScopeID)
+
+	_ = i.Shutdown()
+	span = tracer.find(SpanNameShutdown, "shutdown")
+	is.NotNil(span)
+	is.True(span.ended)
+	is.NoError(span.err)
+}
+
+func TestTracer_disabled(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	i := New()
+	vScope := newVirtualScope(i, []string{"foobar"})
+
+	injector, ctx, span := startBuildSpan(vScope)
+	is.Equal(vScope, injector)
+	is.Equal(context.Background(), ctx)
+	is.Equal(noopSpan{}, span)
+
+	injector, ctx, span = startBuildSpan(i)
+	is.Equal(i, injector)
+	is.Equal(context.Background(), ctx)
+	is.Equal(noopSpan{}, span)
+}
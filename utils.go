@@ -235,6 +235,32 @@ func raceWithTimeout(ctx context.Context, fn func(context.Context) error) error
 	}
 }
 
+// raceWithContext runs fn, and returns errTimeout as soon as the context is done, without waiting
+// for fn to return. fn keeps running in the background: its result is ignored.
+func raceWithContext(ctx context.Context, fn func(context.Context) error, errTimeout error) error {
+	if ctx.Done() == nil {
+		return fn(ctx)
+	}
+
+	err := make(chan error, 1)
+	go func() {
+		err <- fn(ctx)
+	}()
+
+	select {
+	case e := <-err:
+		return e
+	case <-ctx.Done():
+		// fn may have returned at the same time.
+		select {
+		case e := <-err:
+			return e
+		default:
+			return fmt.Errorf("%w: %s", errTimeout, ctx.Err()) //nolint:errorlint
+		}
+	}
+}
+
 // Previously, we used to perform check like this:
 // _, ok := any(empty[Initial]()).(Alias)
 // But it was not working when Initial was an interface, so we now
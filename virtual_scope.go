@@ -18,9 +18,22 @@ func newVirtualScope(predecessor Injector, invokerChain []string) *virtualScope
 	return &virtualScope{
 		self:         predecessor,
 		invokerChain: invokerChain,
+		ctx:          nil,
 	}
 }
 
+// newInvocationScope creates the virtual scope passed to a service being invoked. The
+// tracing context of the invoker, if any, is propagated to the invoked service.
+func newInvocationScope(invoker Injector, serviceScope Injector, invokerChain []string) *virtualScope {
+	vScope := newVirtualScope(serviceScope, invokerChain)
+
+	if parent, ok := invoker.(*virtualScope); ok {
+		vScope.ctx = parent.ctx
+	}
+
+	return vScope
+}
+
 // virtualScope is a simple wrapper to Injector (Scope or RootScope or virtualScope) that
 // contains the invoker name.
 // It is used to track the dependency graph.
@@ -34,9 +47,29 @@ func newVirtualScope(predecessor Injector, invokerChain []string) *virtualScope
 // Fields:
 //   - self: The underlying injector being wrapped
 //   - invokerChain: The chain of service names that have been invoked, used for circular dependency detection
+//   - ctx: The tracing context of the provider build, used to nest the spans of its dependencies
 type virtualScope struct {
 	self         Injector
 	invokerChain []string
+	ctx          context.Context
+}
+
+// invocationContext returns the tracing context of the virtual scope, or a background context.
+func (s *virtualScope) invocationContext() context.Context {
+	if s.ctx == nil {
+		return context.Background()
+	}
+
+	return s.ctx
+}
+
+// withContext returns a copy of the virtual scope carrying the provided tracing context.
+func (s *virtualScope) withContext(ctx context.Context) *virtualScope {
+	return &virtualScope{
+		self:         s.self,
+		invokerChain: s.invokerChain,
+		ctx:          ctx,
+	}
 }
 
 // pass through
@@ -59,10 +92,41 @@ func (s *virtualScope) HealthCheck() map[string]error { return s.self.HealthChec
 func (s *virtualScope) HealthCheckWithContext(ctx context.Context) map[string]error {
 	return s.self.HealthCheckWithContext(ctx)
 }
+func (s *virtualScope) ReadinessCheck() map[string]error { return s.self.ReadinessCheck() }
+func (s *virtualScope) ReadinessCheckWithContext(ctx context.Context) map[string]error {
+	return s.self.ReadinessCheckWithContext(ctx)
+}
+func (s *virtualScope) LivenessCheck() map[string]error { return s.self.LivenessCheck() }
+func (s *virtualScope) LivenessCheckWithContext(ctx context.Context) map[string]error {
+	return s.self.LivenessCheckWithContext(ctx)
+}
+
+func (s *virtualScope) HealthCheckTree() HealthCheckTreeOutput { return s.self.HealthCheckTree() }
+func (s *virtualScope) HealthCheckTreeWithContext(ctx context.Context) HealthCheckTreeOutput {
+	return s.self.HealthCheckTreeWithContext(ctx)
+}
+
+func (s *virtualScope) ShutdownPlan() ShutdownPlanOutput { return s.self.ShutdownPlan() }
+
+func (s *virtualScope) HealthReport(opts HealthReportOpts) *HealthReport {
+	return s.self.HealthReport(opts)
+}
+
+func (s *virtualScope) HealthReportWithContext(ctx context.Context, opts HealthReportOpts) *HealthReport {
+	return s.self.HealthReportWithContext(ctx, opts)
+}
 func (s *virtualScope) Shutdown() *ShutdownReport { return s.self.Shutdown() }
 func (s *virtualScope) ShutdownWithContext(ctx context.Context) *ShutdownReport {
 	return s.self.ShutdownWithContext(ctx)
 }
+func (s *virtualScope) Reset() *ShutdownReport { return s.self.Reset() }
+func (s *virtualScope) ResetWithContext(ctx context.Context) *ShutdownReport {
+	return s.self.ResetWithContext(ctx)
+}
+func (s *virtualScope) Restart() (*ShutdownReport, error) { return s.self.Restart() }
+func (s *virtualScope) RestartWithContext(ctx context.Context) (*ShutdownReport, error) {
+	return s.self.RestartWithContext(ctx)
+}
 func (s *virtualScope) clone(r *RootScope, p *Scope) *Scope              { return s.self.clone(r, p) }
 func (s *virtualScope) serviceExist(name string) bool                    { return s.self.serviceExist(name) }
 func (s *virtualScope) serviceExistRec(name string) bool                 { return s.self.serviceExistRec(name) }
@@ -79,6 +143,10 @@ func (s *virtualScope) serviceHealthCheck(ctx context.Context, n string) error {
 	return s.self.serviceHealthCheck(ctx, n)
 }
 
+func (s *virtualScope) serviceCheck(ctx context.Context, n string, kind HealthCheckKind) error {
+	return s.self.serviceCheck(ctx, n, kind)
+}
+
 func (s *virtualScope) serviceShutdown(ctx context.Context, name string) error {
 	return s.self.serviceShutdown(ctx, name)
 }